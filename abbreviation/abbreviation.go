@@ -0,0 +1,251 @@
+// Package abbreviation provides the functions to generate the short forms a developer could write for
+// a given long form, which is the reverse operation of the expansion algorithms.
+//
+// Candidates are generated using the same transformation families recognised by the expanders: truncation,
+// dropped letters and word combination (as AMAP patterns), removed vowels and removed characters (as GenTest
+// filters), and acronyms.
+package abbreviation
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind identifies the transformation used to generate a short form.
+type Kind string
+
+// Available kinds of short forms.
+const (
+	// Truncation keeps the leading letters of the word, such as "config" for "configuration".
+	Truncation Kind = "truncation"
+	// DroppedLetters keeps the first letter and the leading consonants of the word, such as "cnfg" for "configuration".
+	DroppedLetters Kind = "dropped-letters"
+	// RemovedVowels removes every vowel of the word, such as "cnfgrtn" for "configuration".
+	RemovedVowels Kind = "removed-vowels"
+	// RemovedChar removes a single character of the word, such as "confguration" for "configuration".
+	RemovedChar Kind = "removed-char"
+	// Acronym keeps the first letter of each word, such as "cm" for "configuration manager".
+	Acronym Kind = "acronym"
+	// WordCombination combines the short forms of each word, such as "confmgr" for "configuration manager".
+	WordCombination Kind = "word-combination"
+)
+
+// kindWeights defines how likely is for a developer to use each kind of short form.
+var kindWeights = map[Kind]float64{
+	Truncation:      1.0,
+	Acronym:         1.0,
+	DroppedLetters:  0.9,
+	WordCombination: 0.85,
+	RemovedVowels:   0.7,
+	RemovedChar:     0.4,
+}
+
+// idealLength is the longest of the most frequent lengths for short forms, which are three or four letters.
+const idealLength = 4
+
+// maxCombinedWords is the maximum number of words on a long form whose word combinations are generated,
+// as each word adds up to five forms to combine. Longer long forms only generate their acronym.
+const maxCombinedWords = 5
+
+// Candidate is a possible short form for a long form.
+type Candidate struct {
+	// ShortForm is the generated short form.
+	ShortForm string
+	// Kind is the transformation used to generate the short form.
+	Kind Kind
+	// Score indicates how plausible is the short form. Higher scores are more plausible.
+	Score float64
+}
+
+// Generate generates the candidate short forms for the given long form, ranked from the most to the least
+// plausible. The long form can hold several words, separated by spaces, hyphens or underscores; word
+// combinations are generated for up to five words.
+// If a short form can be generated by different transformations, only the most plausible is kept.
+func Generate(longForm string) []Candidate {
+	words := strings.FieldsFunc(strings.ToLower(longForm), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	candidates := make(map[string]Candidate)
+	add := func(shortForm string, kind Kind) {
+		if utf8.RuneCountInString(shortForm) < 2 || shortForm == strings.Join(words, "") {
+			return
+		}
+
+		candidate := Candidate{ShortForm: shortForm, Kind: kind, Score: score(shortForm, kind)}
+		if current, ok := candidates[shortForm]; !ok || candidate.Score > current.Score {
+			candidates[shortForm] = candidate
+		}
+	}
+
+	switch len(words) {
+	case 0:
+		return []Candidate{}
+	case 1:
+		for _, c := range singleWord(words[0]) {
+			add(c.ShortForm, c.Kind)
+		}
+	default:
+		var acronym strings.Builder
+		for _, w := range words {
+			first, _ := utf8.DecodeRuneInString(w)
+			acronym.WriteRune(first)
+		}
+		add(acronym.String(), Acronym)
+
+		if len(words) <= maxCombinedWords {
+			for _, combination := range combinations(words) {
+				add(combination, WordCombination)
+			}
+		}
+	}
+
+	ranked := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].ShortForm < ranked[j].ShortForm
+	})
+
+	return ranked
+}
+
+// singleWord generates the short forms for a single word.
+func singleWord(word string) []Candidate {
+	letters := []rune(word)
+	candidates := make([]Candidate, 0)
+	if len(letters) < 3 {
+		return candidates
+	}
+
+	// truncations
+	for i := 2; i < len(letters); i++ {
+		candidates = append(candidates, Candidate{ShortForm: string(letters[:i]), Kind: Truncation})
+	}
+
+	// dropped letters: the first letter and the leading consonants, or the consonants starting a syllable
+	skeleton := append([]rune{letters[0]}, removeVowels(letters[1:])...)
+	for _, dropped := range [][]rune{collapseDoubles(skeleton), onsets(letters)} {
+		for i := 2; i <= len(dropped) && i <= idealLength; i++ {
+			candidates = append(candidates, Candidate{ShortForm: string(dropped[:i]), Kind: DroppedLetters})
+		}
+	}
+
+	// removed vowels, also after removing a single char
+	withoutVowels := removeVowels(letters)
+	if string(withoutVowels) != word {
+		candidates = append(candidates, Candidate{ShortForm: string(withoutVowels), Kind: RemovedVowels})
+	}
+	if string(skeleton) != string(withoutVowels) {
+		candidates = append(candidates, Candidate{ShortForm: string(skeleton), Kind: RemovedVowels})
+	}
+	for i := 1; i < len(withoutVowels); i++ {
+		candidates = append(candidates, Candidate{ShortForm: removeAt(withoutVowels, i), Kind: RemovedVowels})
+	}
+
+	// removed char, keeping the first letter
+	for i := 1; i < len(letters); i++ {
+		candidates = append(candidates, Candidate{ShortForm: removeAt(letters, i), Kind: RemovedChar})
+	}
+
+	return candidates
+}
+
+// combinations generates the short forms combining the most plausible short forms of each word.
+func combinations(words []string) []string {
+	combined := []string{""}
+	for _, w := range words {
+		forms := wordForms(w)
+
+		next := make([]string, 0, len(combined)*len(forms))
+		for _, prefix := range combined {
+			for _, form := range forms {
+				next = append(next, prefix+form)
+			}
+		}
+		combined = next
+	}
+
+	return combined
+}
+
+// wordForms returns the forms a word can take on a word combination: the first letter, the word itself
+// if it's short, and its most plausible truncations and dropped letters.
+func wordForms(word string) []string {
+	letters := []rune(word)
+	forms := []string{string(letters[:1])}
+	if len(letters) <= idealLength {
+		return append(forms, word)
+	}
+
+	forms = append(forms, string(letters[:3]), string(letters[:idealLength]))
+	skeleton := append([]rune{letters[0]}, removeVowels(letters[1:])...)
+	if len(skeleton) >= 3 {
+		forms = append(forms, string(skeleton[:3]))
+	}
+
+	return forms
+}
+
+// score calculates how plausible is a short form, based on its kind and its length.
+// Acronyms are not penalized by their length.
+func score(shortForm string, kind Kind) float64 {
+	if kind == Acronym {
+		return kindWeights[kind]
+	}
+
+	distance := math.Abs(float64(utf8.RuneCountInString(shortForm)) - (idealLength - 0.5))
+	return kindWeights[kind] / (1 + 0.5*distance)
+}
+
+// onsets returns the first letter of the word, and the consonants followed by a vowel.
+func onsets(letters []rune) []rune {
+	result := []rune{letters[0]}
+	for i := 1; i < len(letters)-1; i++ {
+		if !isVowel(letters[i]) && isVowel(letters[i+1]) {
+			result = append(result, letters[i])
+		}
+	}
+
+	return result
+}
+
+// collapseDoubles replaces every repeated letter by a single letter.
+func collapseDoubles(letters []rune) []rune {
+	result := make([]rune, 0, len(letters))
+	for i, r := range letters {
+		if i == 0 || r != letters[i-1] {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
+
+// removeAt returns the letters as a string, without the letter found at the given index.
+func removeAt(letters []rune, i int) string {
+	return string(letters[:i]) + string(letters[i+1:])
+}
+
+// isVowel checks if the letter is a vowel, including the accented vowels used on Spanish and Portuguese.
+func isVowel(r rune) bool {
+	return strings.ContainsRune("aeiouáéíóúàâêôãõü", r)
+}
+
+func removeVowels(letters []rune) []rune {
+	result := make([]rune, 0, len(letters))
+	for _, r := range letters {
+		if !isVowel(r) {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
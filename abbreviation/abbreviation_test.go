@@ -0,0 +1,124 @@
+package abbreviation
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/eroatta/token/amap"
+	"github.com/eroatta/token/basic"
+	"github.com/eroatta/token/expansion"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate_ShouldReturnRankedCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		longForm string
+		want     []string
+	}{
+		{"truncation", "configuration", []string{"con", "conf"}},
+		{"dropped_letters", "configuration", []string{"cfg", "cnfg"}},
+		{"dropped_letters_with_doubled_consonants", "button", []string{"btn"}},
+		{"dropped_letters_with_syllables", "message", []string{"msg"}},
+		{"removed_vowels", "message", []string{"mssg"}},
+		{"removed_char", "message", []string{"mesage"}},
+		{"acronym", "configuration manager", []string{"cm"}},
+		{"word_combination", "configuration manager", []string{"confm", "cnfman"}},
+		{"separated_by_hyphens_and_underscores", "file-transfer_protocol", []string{"ftp"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Generate(tt.longForm)
+
+			shortForms := make([]string, len(got))
+			for i, c := range got {
+				shortForms[i] = c.ShortForm
+			}
+			assert.Subset(t, shortForms, tt.want)
+		})
+	}
+}
+
+func TestGenerate_ShouldRankMostPlausibleCandidatesFirst(t *testing.T) {
+	got := Generate("configuration manager")
+
+	assert.Equal(t, Candidate{ShortForm: "cm", Kind: Acronym, Score: 1.0}, got[0])
+	for i := 1; i < len(got); i++ {
+		assert.True(t, got[i-1].Score >= got[i].Score, "candidates should be ranked by score")
+	}
+}
+
+func TestGenerate_OnLongFormWithManyWords_ShouldOnlyReturnTheAcronym(t *testing.T) {
+	got := Generate("the quick brown fox jumps over the lazy dog near the river bank")
+
+	assert.Equal(t, []Candidate{{ShortForm: "tqbfjotldntrb", Kind: Acronym, Score: 1.0}}, got)
+}
+
+func TestGenerate_OnAccentedLongForm_ShouldReturnValidShortForms(t *testing.T) {
+	tests := []struct {
+		name     string
+		longForm string
+		want     []string
+	}{
+		{"single_word", "configuração", []string{"config", "cnfg", "configuraço"}},
+		{"multiple_words", "ñandú árbol", []string{"ñá", "ñandá"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Generate(tt.longForm)
+
+			shortForms := make([]string, len(got))
+			for i, c := range got {
+				assert.True(t, utf8.ValidString(c.ShortForm), "invalid short form %q", c.ShortForm)
+				shortForms[i] = c.ShortForm
+			}
+			assert.Subset(t, shortForms, tt.want)
+		})
+	}
+}
+
+func TestGenerate_ShouldNotReturnLongFormOrSingleLetters(t *testing.T) {
+	tests := []struct {
+		name     string
+		longForm string
+	}{
+		{"single_word", "go"},
+		{"single_letter", "a"},
+		{"empty", ""},
+		{"only_separators", " - "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Generate(tt.longForm)
+
+			assert.Empty(t, got)
+		})
+	}
+}
+
+func TestGenerate_OnSingleWord_ShouldRoundTripWithBasic(t *testing.T) {
+	longForms := []string{"configuration", "message", "button", "context", "parser"}
+
+	for _, longForm := range longForms {
+		words := expansion.NewSetBuilder().AddStrings(longForm).Build()
+		for _, candidate := range Generate(longForm) {
+			got := basic.Expand(candidate.ShortForm, words, map[string]string{}, words)
+
+			assert.Contains(t, got, longForm, "%s should expand to %s", candidate.ShortForm, longForm)
+		}
+	}
+}
+
+func TestGenerate_OnMultipleWords_ShouldRoundTripWithAmap(t *testing.T) {
+	longForm := "configuration manager"
+	scope := amap.NewTokenScope([]string{}, "", "", []string{"loads the " + longForm}, []string{})
+
+	for _, candidate := range Generate(longForm)[:5] {
+		got := amap.Expand(candidate.ShortForm, scope, []string{})
+
+		assert.Equal(t, []string{longForm}, got, "%s (%s) should expand to %s", candidate.ShortForm, candidate.Kind, longForm)
+	}
+}
@@ -6,15 +6,18 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/eroatta/token/errs"
 	porterstemmer "github.com/reiver/go-porterstemmer"
 )
 
 type searchExpansion func(pattern, TokenScope) []string
 
 var (
-	consonants, _ = regexp.Compile("[a-z][^aeiou]+")
-	manyVowels, _ = regexp.Compile("[a-z][aeiou][aeiou]+")
-	searchers     = map[string]searchExpansion{
+	consonants   = regexp.MustCompile("[a-z][^aeiou]+")
+	manyVowels   = regexp.MustCompile("[a-z][aeiou][aeiou]+")
+	lowerToUpper = regexp.MustCompile("([a-z])([A-Z])")
+	nonLetters   = regexp.MustCompile("[^A-Za-z]+")
+	searchers    = map[string]searchExpansion{
 		singleWordGroup: searchSingleWordExpansion,
 		multiWordGroup:  searchMultiWordExpansion,
 	}
@@ -27,9 +30,17 @@ type TokenScope struct {
 	methodBodyText       string
 	methodComments       []string
 	packageComments      []string
+	statements           []string
+	identifiers          []string
+	receiverType         string
+	structFields         []string
+	fileComments         []string
+	imports              []string
+	program              *ProgramScope
 }
 
 // NewTokenScope creates a new token scope.
+// A TokenScopeBuilder can be used instead, to set each scope level by name and to set further levels.
 func NewTokenScope(variableDeclarations []string, methodName string, methodBodyText string,
 	methodComments []string, packageComments []string) TokenScope {
 	return TokenScope{
@@ -41,19 +52,108 @@ func NewTokenScope(variableDeclarations []string, methodName string, methodBodyT
 	}
 }
 
+// WithStatements returns a copy of the token scope holding the given method statements,
+// such as "buf := new(bytes.Buffer)". Each statement is stored as its lower case words.
+func (ts TokenScope) WithStatements(statements ...string) TokenScope {
+	ts.statements = joinedWords(statements)
+	return ts
+}
+
+// WithIdentifiers returns a copy of the token scope holding the identifiers declared or used on the
+// method, such as "jsonParserFactory". Each identifier is stored as its lower case words.
+func (ts TokenScope) WithIdentifiers(identifiers ...string) TokenScope {
+	ts.identifiers = joinedWords(identifiers)
+	return ts
+}
+
+// WithProgram returns a copy of the token scope linked to the given program scope, which is searched
+// when there are several candidate long forms, before using the most frequent expansion.
+func (ts TokenScope) WithProgram(program *ProgramScope) TokenScope {
+	ts.program = program
+	return ts
+}
+
+// words splits a piece of source code on its lower case words, using any non-letter character and
+// the lower to upper case transitions as separators.
+func words(code string) []string {
+	code = lowerToUpper.ReplaceAllString(code, "$1 $2")
+	return strings.Fields(strings.ToLower(nonLetters.ReplaceAllString(code, " ")))
+}
+
+// joinedWords splits each piece of source code on its lower case words, joined by spaces.
+func joinedWords(code []string) []string {
+	joined := make([]string, 0, len(code))
+	for _, c := range code {
+		joined = append(joined, strings.Join(words(c), " "))
+	}
+
+	return joined
+}
+
 // Expand on AMAP receives a token and returns and array of possible expansions.
 //
 // The AMAP expansion algorithm handles single-word and multi-word abbreviations.
 // For each type of abbreviation AMAP creates and applies a pattern to look for possible
 // expansions. AMAP is capable of select the more appropriate expansions based on available
 // information on the given context.
-func Expand(token string, scope TokenScope, referenceText []string) []string {
+//
+// The patterns for each token are compiled once and cached for further calls. Tokens whose patterns
+// can't be compiled have no expansions.
+//
+// When the most frequent expansion is needed, the reference text is scanned looking for the phrases
+// matching the pattern, which costs a pass over the whole text on each call. ExpandWithIndex should be
+// used when expanding many tokens against the same reference text.
+//
+// The reference text is processed as NewReferenceIndex does, so the same options can be given, such
+// as WithExcludedWords(lists.EnglishStop).
+func Expand(token string, scope TokenScope, referenceText []string, options ...IndexOption) []string {
+	expansions, _ := expand(token, scope, func(pttrn pattern) map[string]int {
+		return scanMatches(pttrn, referenceText, options...)
+	})
+	return expansions
+}
+
+// ExpandWithIndex on AMAP works as Expand, but uses a pre-built index of the reference text, which
+// avoids scanning the whole reference text each time the most frequent expansion is needed.
+//
+//	index := amap.NewReferenceIndex(referenceText)
+//	for _, token := range tokens {
+//		expansions := amap.ExpandWithIndex(token, scope, index)
+//	}
+func ExpandWithIndex(token string, scope TokenScope, index *ReferenceIndex) []string {
+	expansions, _ := expand(token, scope, index.matches)
+	return expansions
+}
+
+// TryExpand on AMAP works as Expand, but returns an error when the token can't be expanded
+// because of the input: errs.ErrEmptyToken for an empty token, or errs.ErrInvalidPattern when
+// the patterns for the token can't be compiled. No expansions and no error means no long form was found.
+func TryExpand(token string, scope TokenScope, referenceText []string, options ...IndexOption) ([]string, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, errs.ErrEmptyToken
+	}
+
+	return expand(token, scope, func(pttrn pattern) map[string]int {
+		return scanMatches(pttrn, referenceText, options...)
+	})
+}
+
+// TryExpandWithIndex on AMAP works as ExpandWithIndex, but returns an error as TryExpand does.
+func TryExpandWithIndex(token string, scope TokenScope, index *ReferenceIndex) ([]string, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, errs.ErrEmptyToken
+	}
+
+	return expand(token, scope, index.matches)
+}
+
+// expand looks for the expansion of the token, using the patterns compiled for the token, which are
+// cached for further calls. An ErrInvalidPattern error is returned if the patterns can't be compiled.
+func expand(token string, scope TokenScope, matches func(pattern) map[string]int) ([]string, error) {
 	token = strings.ToLower(token)
-	patterns := []pattern{
-		(&patternBuilder{}).kind(acronymType).shortForm(token).build(),
-		(&patternBuilder{}).kind(prefixType).shortForm(token).build(),
-		(&patternBuilder{}).kind(droppedLettersType).shortForm(token).build(),
-		(&patternBuilder{}).kind(wordCombinationType).shortForm(token).build(),
+	patterns, err := cache.patterns(token)
+	if err != nil {
+		return nil, err
 	}
 
 	var expansion string
@@ -66,7 +166,7 @@ func Expand(token string, scope TokenScope, referenceText []string) []string {
 		}
 
 		if len(longForms) > 1 {
-			expansion = findMostFrequentLongForm(pttrn, longForms, referenceText)
+			expansion = findMostFrequentLongForm(pttrn, longForms, scope.program, matches)
 			break
 		}
 	}
@@ -76,7 +176,7 @@ func Expand(token string, scope TokenScope, referenceText []string) []string {
 		expansions = append(expansions, expansion)
 	}
 
-	return expansions
+	return expansions, nil
 }
 
 // searchSingleWordExpansion looks for candidate long forms for a given pattern, focusing on single word expansions.
@@ -89,7 +189,7 @@ func searchSingleWordExpansion(pttrn pattern, scope TokenScope) []string {
 		!manyVowels.MatchString(pttrn.shortForm) {
 
 		// 9: Search TypeNames and corresponding declared variable names for “pattern sf”
-		matcher, _ := regexp.Compile(pttrn.regex + "[ ]" + pttrn.shortForm)
+		matcher := pttrn.declarationMatcher
 		for _, v := range scope.variableDeclarations {
 			if matcher.MatchString(v) {
 				// append only the matching name to the candidate expansions
@@ -101,7 +201,7 @@ func searchSingleWordExpansion(pttrn pattern, scope TokenScope) []string {
 		}
 
 		// 10: Search MethodName for “pattern”
-		matcher, _ = regexp.Compile(pttrn.regex)
+		matcher = pttrn.matcher
 		if matcher.MatchString(scope.methodName) {
 			longForms = append(longForms, scope.methodName)
 			if len(longForms) == 1 {
@@ -109,11 +209,16 @@ func searchSingleWordExpansion(pttrn pattern, scope TokenScope) []string {
 			}
 		}
 
-		// 11: Search Statements for “pattern sf” and “sf pattern” (ignored)
+		// 11: Search Statements for “pattern sf” and “sf pattern”
+		for _, stmt := range scope.statements {
+			longForms = append(longForms, statementMatches(matcher, pttrn.shortForm, stmt)...)
+		}
+		if len(longForms) == 1 {
+			return longForms
+		}
 
 		if len(pttrn.shortForm) != 2 {
 			// 13: Search method words for “pattern”
-			matcher, _ := regexp.Compile(pttrn.regex)
 			longForms = append(longForms, matcher.FindAllString(scope.methodBodyText, -1)...)
 			if len(longForms) == 1 {
 				return longForms
@@ -128,14 +233,25 @@ func searchSingleWordExpansion(pttrn pattern, scope TokenScope) []string {
 			}
 		}
 		if pttrn.kind == prefixType && len(pttrn.shortForm) > 1 {
+			// 16: Search class name and field names for “pattern”
+			longForms = append(longForms, typeMatches(matcher, scope)...)
+			if len(longForms) == 1 {
+				return longForms
+			}
+
 			// 17: Search class comment words for “pattern”
-			matcher, _ := regexp.Compile(pttrn.regex)
-			for _, pComm := range scope.packageComments {
-				longForms = append(longForms, matcher.FindAllString(pComm, -1)...)
+			for _, comm := range classComments(scope) {
+				longForms = append(longForms, matcher.FindAllString(comm, -1)...)
 				if len(longForms) == 1 {
 					return longForms
 				}
 			}
+
+			// 18: Search imported package names for “pattern”
+			longForms = append(longForms, importMatches(matcher, scope)...)
+			if len(longForms) == 1 {
+				return longForms
+			}
 		}
 	}
 
@@ -143,13 +259,68 @@ func searchSingleWordExpansion(pttrn pattern, scope TokenScope) []string {
 	return longForms
 }
 
+// typeMatches looks for the pattern on the receiver type name and the struct field names,
+// which are the Go counterpart of the class name and class field names.
+func typeMatches(matcher *regexp.Regexp, scope TokenScope) []string {
+	matches := matcher.FindAllString(scope.receiverType, -1)
+	for _, field := range scope.structFields {
+		matches = append(matches, matcher.FindAllString(field, -1)...)
+	}
+
+	return matches
+}
+
+// classComments retrieves the comments at class level, starting with the file comments
+// and followed by the package comments.
+func classComments(scope TokenScope) []string {
+	comments := make([]string, 0, len(scope.fileComments)+len(scope.packageComments))
+	comments = append(comments, scope.fileComments...)
+	return append(comments, scope.packageComments...)
+}
+
+// importMatches looks for the pattern on the imported package names.
+func importMatches(matcher *regexp.Regexp, scope TokenScope) []string {
+	var matches []string
+	for _, imp := range scope.imports {
+		matches = append(matches, matcher.FindAllString(imp, -1)...)
+	}
+
+	return matches
+}
+
+// statementMatches looks for words matching the pattern on a statement that contains the short form,
+// either before (“pattern sf”) or after it (“sf pattern”), as in "buf new bytes buffer".
+func statementMatches(matcher *regexp.Regexp, shortForm string, statement string) []string {
+	stmtWords := strings.Split(statement, " ")
+
+	containsShortForm := false
+	for _, w := range stmtWords {
+		if w == shortForm {
+			containsShortForm = true
+			break
+		}
+	}
+	if !containsShortForm {
+		return nil
+	}
+
+	var matches []string
+	for _, w := range stmtWords {
+		if w != shortForm && matcher.FindString(w) == w {
+			matches = append(matches, w)
+		}
+	}
+
+	return matches
+}
+
 // searchMultiWordExpansion looks for candidate long forms for a given pattern, focusing on single word expansions.
 func searchMultiWordExpansion(pttrn pattern, scope TokenScope) []string {
 	var longForms []string
 
 	if pttrn.kind == acronymType || len(pttrn.shortForm) > 3 {
 		// 9: Search TypeNames and corresponding declared variable names for “pattern sf”
-		matcher, _ := regexp.Compile(pttrn.regex + "[ ]" + pttrn.shortForm)
+		matcher := pttrn.declarationMatcher
 		for _, v := range scope.variableDeclarations {
 			if matcher.MatchString(v) {
 				// append only the matching name to the candidate expansions
@@ -161,7 +332,7 @@ func searchMultiWordExpansion(pttrn pattern, scope TokenScope) []string {
 		}
 
 		// 10: Search MethodName for “pattern”
-		matcher, _ = regexp.Compile(pttrn.regex)
+		matcher = pttrn.matcher
 		if matcher.MatchString(scope.methodName) {
 			longForms = append(longForms, scope.methodName)
 			if len(longForms) == 1 {
@@ -169,7 +340,13 @@ func searchMultiWordExpansion(pttrn pattern, scope TokenScope) []string {
 			}
 		}
 
-		// 11: Search all identifiers in the method for “pattern” (ignored)
+		// 11: Search all identifiers in the method for “pattern”
+		for _, id := range scope.identifiers {
+			longForms = append(longForms, matcher.FindAllString(id, -1)...)
+		}
+		if len(longForms) == 1 {
+			return longForms
+		}
 
 		// 12: Search string literals for “pattern”
 		longForms = append(longForms, matcher.FindAllString(scope.methodBodyText, -1)...)
@@ -185,14 +362,26 @@ func searchMultiWordExpansion(pttrn pattern, scope TokenScope) []string {
 			}
 		}
 
-		// 15: If acronym, search class comment words for “pattern”
 		if pttrn.kind == acronymType {
-			for _, pComm := range scope.packageComments {
-				longForms = append(longForms, matcher.FindAllString(pComm, -1)...)
+			// 14: If acronym, search class name and field names for “pattern”
+			longForms = append(longForms, typeMatches(matcher, scope)...)
+			if len(longForms) == 1 {
+				return longForms
+			}
+
+			// 15: If acronym, search class comment words for “pattern”
+			for _, comm := range classComments(scope) {
+				longForms = append(longForms, matcher.FindAllString(comm, -1)...)
 				if len(longForms) == 1 {
 					return longForms
 				}
 			}
+
+			// 16: If acronym, search imported package names for “pattern”
+			longForms = append(longForms, importMatches(matcher, scope)...)
+			if len(longForms) == 1 {
+				return longForms
+			}
 		}
 	}
 
@@ -203,8 +392,11 @@ func searchMultiWordExpansion(pttrn pattern, scope TokenScope) []string {
 // The process follows several steps. On the first step, it uses the long form that most frequently matches the
 // short form’s pattern in this scope.
 // On the second step, words with the same stem are grouped and the frequencies updated accordingly.
+// On the third step, the long form that most frequently matches the short form's pattern on the program
+// is used, if a program scope is available.
 // Finally, if the previous steps fail, the MFE process is used.
-func findMostFrequentLongForm(pttrn pattern, longForms []string, referenceText []string) string {
+func findMostFrequentLongForm(pttrn pattern, longForms []string, program *ProgramScope,
+	matches func(pattern) map[string]int) string {
 	// step 1: use the long form that most frequently matches the short form's pattern in this scope
 	mfw := mostFrequentWord(longForms)
 	if mfw != "" {
@@ -217,10 +409,14 @@ func findMostFrequentLongForm(pttrn pattern, longForms []string, referenceText [
 		return mfw
 	}
 
-	// step 3: skipped, because we continue searching at broader levels if multiple matches are found
+	// step 3: use the long form that most frequently matches the short form's pattern in the program
+	mfw = program.mostFrequentCandidate(pttrn, longForms)
+	if mfw != "" {
+		return mfw
+	}
 
 	// step 4: use MFE
-	mfw = mostFrequentExpansion(pttrn, referenceText)
+	mfw = mostFrequentExpansion(matches(pttrn))
 
 	return mfw
 }
@@ -275,19 +471,11 @@ func stemmedWords(words []string) []string {
 }
 
 // mostFrequentExpansion calculates the most frequent expansion based on the number of matches
-// between the short form and the given text.
-func mostFrequentExpansion(pttrn pattern, text []string) string {
+// between the short form and the reference text, given the matches found on the reference text.
+func mostFrequentExpansion(results map[string]int) string {
 	var totalMatches int
-	results := make(map[string]int, 0)
-
-	matcher, _ := regexp.Compile(pttrn.regex)
-	for _, t := range text {
-		matches := matcher.FindAllString(t, -1)
-		totalMatches += len(matches)
-
-		for _, match := range matches {
-			results[match]++
-		}
+	for _, count := range results {
+		totalMatches += count
 	}
 
 	type relativeFreq struct {
@@ -1,9 +1,11 @@
 package amap
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/eroatta/token/errs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,6 +20,33 @@ func TestNewTokenScope_ShouldReturnNewTokenScope(t *testing.T) {
 	assert.ElementsMatch(t, []string{"package comments"}, scope.packageComments)
 }
 
+func TestWithStatements_OnTokenScope_ShouldReturnScopeWithStatementWords(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "", []string{}, []string{})
+
+	got := scope.WithStatements("buf := new(bytes.Buffer)", "jsonParser.Parse(src)")
+
+	assert.Empty(t, scope.statements)
+	assert.Equal(t, []string{"buf new bytes buffer", "json parser parse src"}, got.statements)
+}
+
+func TestWithIdentifiers_OnTokenScope_ShouldReturnScopeWithIdentifierWords(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "", []string{}, []string{})
+
+	got := scope.WithIdentifiers("jsonParserFactory", "file_transfer_protocol", "i")
+
+	assert.Empty(t, scope.identifiers)
+	assert.Equal(t, []string{"json parser factory", "file transfer protocol", "i"}, got.identifiers)
+}
+
+func TestExpand_OnAmapWithStatements_ShouldReturnExpansionFromStatement(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "", []string{}, []string{}).
+		WithStatements("buf := new(bytes.Buffer)", "n := len(items)")
+
+	got := Expand("buf", scope, []string{})
+
+	assert.Equal(t, []string{"buffer"}, got)
+}
+
 func TestExpand_OnAmap_ShouldReturnExpansion(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -46,6 +75,30 @@ func TestExpand_OnAmap_ShouldReturnExpansion(t *testing.T) {
 	}
 }
 
+func TestExpand_OnAmapWithMetacharacters_ShouldMatchLiterally(t *testing.T) {
+	cases := []struct {
+		name     string
+		token    string
+		expected []string
+	}{
+		{"plus_sign", "a+b", []string{}},
+		{"open_bracket", "x[", []string{}},
+		{"invalid_utf8", "a\xffb", []string{}},
+	}
+
+	methodBodyText := "aab abb xylophone expansion"
+	scope := NewTokenScope([]string{}, "", methodBodyText, []string{}, []string{})
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			var got []string
+			assert.NotPanics(t, func() { got = Expand(fixture.token, scope, []string{}) })
+
+			assert.ElementsMatch(t, fixture.expected, got)
+		})
+	}
+}
+
 func TestSingleWordExpansion_OnAmapWithNoMatches_ShouldReturnEmptyLongForms(t *testing.T) {
 	pattern := (&patternBuilder{}).kind("prefix").shortForm("cp").build()
 
@@ -160,6 +213,40 @@ func TestSingleWordExpansion_OnAmapWithPrefixPatternButNoSingleMatch_ShouldRetur
 	assert.ElementsMatch(t, []string{"abstraction", "abstract", "absolute"}, got, fmt.Sprintf("found elements: %v", got))
 }
 
+func TestSingleWordExpansion_OnAmapWithStatements_ShouldReturnMatchingLongForms(t *testing.T) {
+	cases := []struct {
+		name        string
+		patternType string
+		shortForm   string
+		expected    []string
+	}{
+		{"match_prefix_after_short_form", "prefix", "buf", []string{"buffer"}},
+		{"match_prefix_before_short_form", "prefix", "conn", []string{"connection"}},
+		{"match_dropped_letters_after_short_form", "dropped-letters", "rdr", []string{"reader"}},
+		{"no_match_on_statements_without_short_form", "prefix", "wri", []string{}},
+		{"multiple_matches_on_statement", "prefix", "str", []string{"string", "strings"}},
+	}
+
+	statements := []string{
+		"buf := new(bytes.Buffer)",
+		"var connection net.Conn = conn",
+		"rdr := bufio.NewReader(file)",
+		"w.Write(data)",
+		"str := strings.TrimSpace(string(data))",
+	}
+	scope := NewTokenScope([]string{}, "", "", []string{}, []string{}).WithStatements(statements...)
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pattern := (&patternBuilder{}).kind(fixture.patternType).shortForm(fixture.shortForm).build()
+
+			got := searchSingleWordExpansion(pattern, scope)
+
+			assert.ElementsMatch(t, fixture.expected, got, fmt.Sprintf("found elements: %v", got))
+		})
+	}
+}
+
 func TestMultiWordExpansion_OnAmapWithNoMatches_ShouldReturnEmptyLongForms(t *testing.T) {
 	pattern := (&patternBuilder{}).kind("acronym").shortForm("json").build()
 
@@ -245,6 +332,32 @@ func TestMultiWordExpansion_OnAmapWithWordCombinationPatternButNoSingleMatch_Sho
 	assert.ElementsMatch(t, []string{"java script", "java scripting", "java script"}, got, fmt.Sprintf("found elements: %v", got))
 }
 
+func TestMultiWordExpansion_OnAmapWithIdentifiers_ShouldReturnMatchingLongForms(t *testing.T) {
+	cases := []struct {
+		name        string
+		patternType string
+		shortForm   string
+		expected    []string
+	}{
+		{"match_acronym_on_identifier", "acronym", "jpf", []string{"json parser factory"}},
+		{"match_word-combination_on_identifier", "word-combination", "ftproto", []string{"file transfer protocol"}},
+		{"no_match_on_identifiers", "acronym", "xml", []string{}},
+	}
+
+	scope := NewTokenScope([]string{}, "", "", []string{}, []string{}).
+		WithIdentifiers("jsonParserFactory", "file_transfer_protocol", "i")
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pattern := (&patternBuilder{}).kind(fixture.patternType).shortForm(fixture.shortForm).build()
+
+			got := searchMultiWordExpansion(pattern, scope)
+
+			assert.ElementsMatch(t, fixture.expected, got, fmt.Sprintf("found elements: %v", got))
+		})
+	}
+}
+
 func TestMostFrequentWord_WhenNoWords_ShouldReturnEmptyWord(t *testing.T) {
 	words := []string{}
 
@@ -315,7 +428,7 @@ func TestMostFrequentExpansion_WhenNoMatches_ShouldReturnEmptyWord(t *testing.T)
 	pttrn := (&patternBuilder{}).kind("prefix").shortForm("val").build()
 	text := []string{"no matching expansion"}
 
-	mfe := mostFrequentExpansion(pttrn, text)
+	mfe := mostFrequentExpansion(NewReferenceIndex(text).matches(pttrn))
 
 	assert.Equal(t, "", mfe)
 }
@@ -333,7 +446,7 @@ func TestMostFrequentExpansion_WhenRelativeFrequencyLessThanZeroFive_ShouldRetur
 		"review validity",
 	}
 
-	mfe := mostFrequentExpansion(pttrn, text)
+	mfe := mostFrequentExpansion(NewReferenceIndex(text).matches(pttrn))
 
 	assert.Equal(t, "", mfe)
 }
@@ -345,7 +458,7 @@ func TestMostFrequentExpansion_WhenLessThanThreeMatches_ShouldReturnEmptyWord(t
 		"another value",
 	}
 
-	mfe := mostFrequentExpansion(pttrn, text)
+	mfe := mostFrequentExpansion(NewReferenceIndex(text).matches(pttrn))
 
 	assert.Equal(t, "", mfe)
 }
@@ -363,7 +476,35 @@ func TestMostFrequentExpansion_WhenExpansionFound_ShouldReturnExpansion(t *testi
 		"check validity",
 	}
 
-	mfe := mostFrequentExpansion(pttrn, text)
+	mfe := mostFrequentExpansion(NewReferenceIndex(text).matches(pttrn))
 
 	assert.Equal(t, "value", mfe)
 }
+
+func TestTryExpand_OnAmap_ShouldReturnExpansionsOrError(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		expected []string
+		err      error
+	}{
+		{"valid_token", "GUI", []string{"graphical user interface"}, nil},
+		{"no_expansion", "zzz", nil, nil},
+		{"metacharacters", "x[", nil, nil},
+		{"empty_token", "", nil, errs.ErrEmptyToken},
+	}
+
+	scope := NewTokenScope([]string{}, "", "", []string{"providing graphical user interface"}, []string{})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryExpand(tt.token, scope, []string{})
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, errors.Is(err, tt.err), fmt.Sprintf("unexpected error: %v", err))
+
+			got, err = TryExpandWithIndex(tt.token, scope, NewReferenceIndex([]string{}))
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, errors.Is(err, tt.err), fmt.Sprintf("unexpected error: %v", err))
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package amap
+
+import (
+	"path"
+	"strings"
+)
+
+// TokenScopeBuilder builds a TokenScope, setting each scope level by name. Levels holding several
+// values, such as the method comments, add the given values to the ones added before.
+//
+//	scope := amap.NewTokenScopeBuilder().
+//		MethodName("marshal").
+//		MethodComments("marshal returns the json encoding of v").
+//		ReceiverType("Encoder").
+//		Imports("encoding/json").
+//		Build()
+type TokenScopeBuilder struct {
+	scope TokenScope
+}
+
+// NewTokenScopeBuilder creates a builder for an empty token scope.
+func NewTokenScopeBuilder() *TokenScopeBuilder {
+	return &TokenScopeBuilder{}
+}
+
+// VariableDeclarations adds the type names and corresponding declared variable names, written as
+// lower case words followed by the variable name (i.e. "string buffer buf").
+func (b *TokenScopeBuilder) VariableDeclarations(declarations ...string) *TokenScopeBuilder {
+	b.scope.variableDeclarations = append(b.scope.variableDeclarations, declarations...)
+	return b
+}
+
+// MethodName sets the name of the method, such as "loadConfig".
+func (b *TokenScopeBuilder) MethodName(name string) *TokenScopeBuilder {
+	b.scope.methodName = strings.Join(words(name), " ")
+	return b
+}
+
+// MethodBodyText sets the words and string literals found on the method body.
+func (b *TokenScopeBuilder) MethodBodyText(text string) *TokenScopeBuilder {
+	b.scope.methodBodyText = text
+	return b
+}
+
+// MethodComments adds the comments of the method.
+func (b *TokenScopeBuilder) MethodComments(comments ...string) *TokenScopeBuilder {
+	b.scope.methodComments = append(b.scope.methodComments, comments...)
+	return b
+}
+
+// Statements adds the statements of the method, such as "buf := new(bytes.Buffer)".
+func (b *TokenScopeBuilder) Statements(statements ...string) *TokenScopeBuilder {
+	b.scope.statements = append(b.scope.statements, joinedWords(statements)...)
+	return b
+}
+
+// Identifiers adds the identifiers declared or used on the method, such as "jsonParserFactory".
+func (b *TokenScopeBuilder) Identifiers(identifiers ...string) *TokenScopeBuilder {
+	b.scope.identifiers = append(b.scope.identifiers, joinedWords(identifiers)...)
+	return b
+}
+
+// ReceiverType sets the type name of the method receiver, such as "JSONEncoder".
+func (b *TokenScopeBuilder) ReceiverType(typeName string) *TokenScopeBuilder {
+	b.scope.receiverType = strings.Join(words(typeName), " ")
+	return b
+}
+
+// StructFields adds the field names of the receiver type, such as "outputBuffer".
+func (b *TokenScopeBuilder) StructFields(fields ...string) *TokenScopeBuilder {
+	b.scope.structFields = append(b.scope.structFields, joinedWords(fields)...)
+	return b
+}
+
+// FileComments adds the comments found on the file holding the method.
+func (b *TokenScopeBuilder) FileComments(comments ...string) *TokenScopeBuilder {
+	b.scope.fileComments = append(b.scope.fileComments, comments...)
+	return b
+}
+
+// PackageComments adds the comments of the package holding the method.
+func (b *TokenScopeBuilder) PackageComments(comments ...string) *TokenScopeBuilder {
+	b.scope.packageComments = append(b.scope.packageComments, comments...)
+	return b
+}
+
+// Imports adds the packages imported by the file holding the method. Import paths are reduced to
+// their package names (i.e. "json" for "encoding/json").
+func (b *TokenScopeBuilder) Imports(imports ...string) *TokenScopeBuilder {
+	for _, imp := range imports {
+		b.scope.imports = append(b.scope.imports, strings.ToLower(path.Base(strings.Trim(imp, `"`))))
+	}
+	return b
+}
+
+// Program sets the program scope, shared by every token scope of the program.
+func (b *TokenScopeBuilder) Program(program *ProgramScope) *TokenScopeBuilder {
+	b.scope.program = program
+	return b
+}
+
+// Build creates the token scope, which doesn't share its values with the builder or other built scopes.
+func (b *TokenScopeBuilder) Build() TokenScope {
+	scope := b.scope
+	scope.variableDeclarations = copyOf(b.scope.variableDeclarations)
+	scope.methodComments = copyOf(b.scope.methodComments)
+	scope.statements = copyOf(b.scope.statements)
+	scope.identifiers = copyOf(b.scope.identifiers)
+	scope.structFields = copyOf(b.scope.structFields)
+	scope.fileComments = copyOf(b.scope.fileComments)
+	scope.packageComments = copyOf(b.scope.packageComments)
+	scope.imports = copyOf(b.scope.imports)
+
+	return scope
+}
+
+// copyOf copies the values, so they don't share the backing array with the given slice.
+func copyOf(values []string) []string {
+	return append([]string(nil), values...)
+}
@@ -0,0 +1,144 @@
+package amap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuild_OnTokenScopeBuilder_ShouldReturnTokenScope(t *testing.T) {
+	program := NewProgramScope([]string{}, []string{}, []string{})
+
+	scope := NewTokenScopeBuilder().
+		VariableDeclarations("string buffer buf").
+		MethodName("marshal").
+		MethodBodyText("encode value").
+		MethodComments("marshal returns the encoding").
+		Statements("buf := new(bytes.Buffer)").
+		Identifiers("jsonParser").
+		ReceiverType("JsonEncoder").
+		StructFields("outputBuffer", "indent").
+		FileComments("file comment").
+		PackageComments("package comment").
+		Imports("encoding/json", `"net/http"`).
+		Program(program).
+		Build()
+
+	assert.Equal(t, []string{"string buffer buf"}, scope.variableDeclarations)
+	assert.Equal(t, "marshal", scope.methodName)
+	assert.Equal(t, "encode value", scope.methodBodyText)
+	assert.Equal(t, []string{"marshal returns the encoding"}, scope.methodComments)
+	assert.Equal(t, []string{"buf new bytes buffer"}, scope.statements)
+	assert.Equal(t, []string{"json parser"}, scope.identifiers)
+	assert.Equal(t, "json encoder", scope.receiverType)
+	assert.Equal(t, []string{"output buffer", "indent"}, scope.structFields)
+	assert.Equal(t, []string{"file comment"}, scope.fileComments)
+	assert.Equal(t, []string{"package comment"}, scope.packageComments)
+	assert.Equal(t, []string{"json", "http"}, scope.imports)
+	assert.True(t, scope.program == program)
+}
+
+func TestBuild_OnIdentifierNames_ShouldNormaliseMethodNameAndReceiverType(t *testing.T) {
+	scope := NewTokenScopeBuilder().
+		MethodName("loadConfig").
+		ReceiverType("loadConfig").
+		Build()
+
+	assert.Equal(t, "load config", scope.methodName)
+	assert.Equal(t, scope.receiverType, scope.methodName)
+}
+
+func TestBuild_OnEmptyTokenScopeBuilder_ShouldReturnEmptyTokenScope(t *testing.T) {
+	scope := NewTokenScopeBuilder().Build()
+
+	assert.Equal(t, TokenScope{}, scope)
+}
+
+func TestBuild_OnBuilderUsedAfterwards_ShouldNotShareValuesBetweenTokenScopes(t *testing.T) {
+	builder := NewTokenScopeBuilder()
+	for _, comment := range []string{"first comment", "second comment", "third comment"} {
+		builder.MethodComments(comment)
+	}
+
+	scope := builder.Build()
+	other := builder.MethodComments("fourth comment").Build()
+	_ = append(scope.methodComments, "changed comment")
+
+	assert.Equal(t, []string{"first comment", "second comment", "third comment"}, scope.methodComments)
+	assert.Equal(t, []string{"first comment", "second comment", "third comment", "fourth comment"},
+		other.methodComments)
+}
+
+func TestSingleWordExpansion_OnAmapWithTypeAndFileLevels_ShouldReturnMatchingLongForms(t *testing.T) {
+	cases := []struct {
+		name      string
+		shortForm string
+		expected  []string
+	}{
+		{"match_on_receiver_type", "enc", []string{"encoder"}},
+		{"match_on_struct_fields", "ind", []string{"indent"}},
+		{"match_on_file_comments", "wr", []string{"writer"}},
+		{"match_on_package_comments", "pars", []string{"parser"}},
+		{"match_on_imports", "str", []string{"strconv"}},
+		{"closer_level_first", "out", []string{"output"}},
+	}
+
+	scope := NewTokenScopeBuilder().
+		ReceiverType("JsonEncoder").
+		StructFields("outputBuffer", "indent").
+		FileComments("the writer handles the output").
+		PackageComments("the parser").
+		Imports("strconv").
+		Build()
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pattern := (&patternBuilder{}).kind("prefix").shortForm(fixture.shortForm).build()
+
+			got := searchSingleWordExpansion(pattern, scope)
+
+			assert.ElementsMatch(t, fixture.expected, got, fmt.Sprintf("found elements: %v", got))
+		})
+	}
+}
+
+func TestMultiWordExpansion_OnAmapWithTypeAndFileLevels_ShouldReturnMatchingLongForms(t *testing.T) {
+	cases := []struct {
+		name      string
+		shortForm string
+		expected  []string
+	}{
+		{"match_on_receiver_type", "gui", []string{"graphical user interface"}},
+		{"match_on_struct_fields", "ob", []string{"output buffer"}},
+		{"match_on_file_comments", "ftp", []string{"file transfer protocol"}},
+		{"file_comments_before_package_comments", "rpc", []string{"remote procedure call"}},
+	}
+
+	scope := NewTokenScopeBuilder().
+		ReceiverType("GraphicalUserInterface").
+		StructFields("outputBuffer").
+		FileComments("uses the file transfer protocol", "remote procedure call").
+		PackageComments("remote procedure calls").
+		Build()
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pattern := (&patternBuilder{}).kind("acronym").shortForm(fixture.shortForm).build()
+
+			got := searchMultiWordExpansion(pattern, scope)
+
+			assert.ElementsMatch(t, fixture.expected, got, fmt.Sprintf("found elements: %v", got))
+		})
+	}
+}
+
+func TestExpand_OnAmapWithTokenScopeBuilder_ShouldReturnExpansion(t *testing.T) {
+	scope := NewTokenScopeBuilder().
+		MethodName("marshal").
+		ReceiverType("Encoder").
+		Imports("encoding/json").
+		Build()
+
+	assert.Equal(t, []string{"encoder"}, Expand("enc", scope, []string{}))
+}
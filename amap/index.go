@@ -0,0 +1,178 @@
+package amap
+
+import (
+	"strings"
+
+	"github.com/eroatta/token/lists"
+)
+
+// maxPhraseWords is the maximum number of words on the phrases stored by a reference index.
+const maxPhraseWords = 5
+
+// ReferenceIndex holds the words and phrases found on a reference text along with their frequencies,
+// so the long forms matching a pattern can be counted without scanning the whole text for each token.
+// Phrases are indexed by their number of words and their first letter, and hold up to five words.
+// The words of each line are also kept, so acronyms longer than five letters are matched by scanning them.
+type ReferenceIndex struct {
+	phrases  [maxPhraseWords + 1]map[byte]map[string]int
+	lines    [][]string
+	excluded lists.List
+}
+
+// IndexOption sets a setting for the reference index.
+type IndexOption func(*ReferenceIndex)
+
+// WithExcludedWords excludes the phrases starting or ending with a word found on the list, such as
+// lists.EnglishStop, so common words don't become the most frequent expansion. Phrases holding the
+// words in between, such as "out of memory", are kept.
+func WithExcludedWords(excluded lists.List) IndexOption {
+	return func(ri *ReferenceIndex) {
+		ri.excluded = excluded
+	}
+}
+
+// NewReferenceIndex builds an index for the given reference text.
+func NewReferenceIndex(referenceText []string, options ...IndexOption) *ReferenceIndex {
+	index := &ReferenceIndex{}
+	for _, option := range options {
+		option(index)
+	}
+
+	for n := 1; n <= maxPhraseWords; n++ {
+		index.phrases[n] = make(map[byte]map[string]int)
+	}
+
+	for _, line := range referenceText {
+		lineWords := words(line)
+		index.lines = append(index.lines, lineWords)
+		index.add(lineWords)
+	}
+
+	return index
+}
+
+// add registers every phrase of up to maxPhraseWords words found on the given sequence of words.
+func (ri *ReferenceIndex) add(lineWords []string) {
+	ri.eachPhrase(lineWords, 1, maxPhraseWords, func(n int, phrase string) {
+		byLetter, ok := ri.phrases[n][phrase[0]]
+		if !ok {
+			byLetter = make(map[string]int)
+			ri.phrases[n][phrase[0]] = byLetter
+		}
+		byLetter[phrase]++
+	})
+}
+
+// eachPhrase calls the function for every phrase holding from minWords to maxWords words found on the
+// sequence of words, skipping the phrases that start or end with an excluded word.
+func (ri *ReferenceIndex) eachPhrase(lineWords []string, minWords int, maxWords int, fn func(n int, phrase string)) {
+	for i := range lineWords {
+		if ri.isExcluded(lineWords[i]) {
+			continue
+		}
+
+		for n := minWords; n <= maxWords && i+n <= len(lineWords); n++ {
+			if ri.isExcluded(lineWords[i+n-1]) {
+				continue
+			}
+
+			fn(n, strings.Join(lineWords[i:i+n], " "))
+		}
+	}
+}
+
+// isExcluded checks if phrases can't start or end with the word.
+func (ri *ReferenceIndex) isExcluded(word string) bool {
+	return ri.excluded != nil && ri.excluded.Contains(word)
+}
+
+// matches retrieves the words or phrases fully matching the pattern, along with their frequencies.
+func (ri *ReferenceIndex) matches(pttrn pattern) map[string]int {
+	results := make(map[string]int)
+	if ri == nil || pttrn.shortForm == "" || pttrn.err != nil {
+		return results
+	}
+
+	minWords, maxWords := phraseWords(pttrn)
+	if maxWords > maxPhraseWords {
+		return ri.scan(pttrn, ri.lines)
+	}
+
+	for n := minWords; n <= maxWords; n++ {
+		for _, letter := range firstLetters(pttrn) {
+			for phrase, count := range ri.phrases[n][letter] {
+				if pttrn.exactMatcher.MatchString(phrase) {
+					results[phrase] += count
+				}
+			}
+		}
+	}
+
+	return results
+}
+
+// scanMatches works as matches on an index of the reference text, but scans the text looking for the
+// phrases matching the pattern, instead of indexing every phrase. It's cheaper when a single pattern
+// is matched against the text.
+func scanMatches(pttrn pattern, referenceText []string, options ...IndexOption) map[string]int {
+	results := make(map[string]int)
+	if pttrn.shortForm == "" || pttrn.err != nil {
+		return results
+	}
+
+	ri := &ReferenceIndex{}
+	for _, option := range options {
+		option(ri)
+	}
+
+	lines := make([][]string, 0, len(referenceText))
+	for _, line := range referenceText {
+		lines = append(lines, words(line))
+	}
+
+	return ri.scan(pttrn, lines)
+}
+
+// scan looks for the phrases matching the pattern on the words of each line, along with their frequencies.
+func (ri *ReferenceIndex) scan(pttrn pattern, lines [][]string) map[string]int {
+	results := make(map[string]int)
+	minWords, maxWords := phraseWords(pttrn)
+	letters := string(firstLetters(pttrn))
+	for _, lineWords := range lines {
+		ri.eachPhrase(lineWords, minWords, maxWords, func(n int, phrase string) {
+			if strings.IndexByte(letters, phrase[0]) >= 0 && pttrn.exactMatcher.MatchString(phrase) {
+				results[phrase]++
+			}
+		})
+	}
+
+	return results
+}
+
+// phraseWords returns the minimum and maximum number of words of the phrases that can match the pattern.
+// Word combinations are limited to maxPhraseWords words, while acronyms hold a word for each letter.
+func phraseWords(pttrn pattern) (int, int) {
+	minWords, maxWords := 1, 1
+	switch pttrn.kind {
+	case acronymType:
+		minWords, maxWords = len(pttrn.shortForm), len(pttrn.shortForm)
+	case wordCombinationType:
+		maxWords = len(pttrn.shortForm)
+		if maxWords > maxPhraseWords {
+			maxWords = maxPhraseWords
+		}
+	}
+
+	return minWords, maxWords
+}
+
+// firstLetters returns the letters the phrases matching the pattern can start with. Short forms starting
+// with "x" can also abbreviate words starting with "e", such as "xt" for "extension".
+func firstLetters(pttrn pattern) []byte {
+	letters := []byte{pttrn.shortForm[0]}
+	if pttrn.shortForm[0] == 'x' {
+		letters = append(letters, 'e')
+	}
+
+	return letters
+}
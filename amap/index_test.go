@@ -0,0 +1,141 @@
+package amap
+
+import (
+	"testing"
+
+	"github.com/eroatta/token/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReferenceIndex_OnReferenceText_ShouldIndexWordsAndPhrases(t *testing.T) {
+	index := NewReferenceIndex([]string{"graphical user interface", "user Interface"})
+
+	assert.Equal(t, 1, index.phrases[1]['g']["graphical"])
+	assert.Equal(t, 2, index.phrases[1]['u']["user"])
+	assert.Equal(t, 2, index.phrases[2]['u']["user interface"])
+	assert.Equal(t, 1, index.phrases[3]['g']["graphical user interface"])
+	assert.Empty(t, index.phrases[4])
+}
+
+func TestMatches_OnReferenceIndex_ShouldReturnMatchingWordsAndPhrases(t *testing.T) {
+	cases := []struct {
+		name        string
+		patternType string
+		shortForm   string
+		expected    map[string]int
+	}{
+		{"prefix_pattern", "prefix", "val", map[string]int{"value": 2, "validation": 1}},
+		{"dropped_letters_pattern", "dropped-letters", "vldtn", map[string]int{"validation": 1}},
+		{"acronym_pattern", "acronym", "gui", map[string]int{"graphical user interface": 2}},
+		{"word_combination_pattern", "word-combination", "usrint", map[string]int{"user interface": 2}},
+		{"leading_x_pattern", "prefix", "xt", map[string]int{"extension": 1}},
+		{"no_matches", "prefix", "zz", map[string]int{}},
+	}
+
+	index := NewReferenceIndex([]string{
+		"check value validation",
+		"the graphical user interface value",
+		"graphical user interface extension",
+	})
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pttrn := (&patternBuilder{}).kind(fixture.patternType).shortForm(fixture.shortForm).build()
+
+			got := index.matches(pttrn)
+
+			assert.Equal(t, fixture.expected, got)
+		})
+	}
+}
+
+func TestMatches_OnNilReferenceIndex_ShouldReturnNoMatches(t *testing.T) {
+	var index *ReferenceIndex
+	pttrn := (&patternBuilder{}).kind("prefix").shortForm("val").build()
+
+	assert.Empty(t, index.matches(pttrn))
+}
+
+func TestNewReferenceIndex_OnExcludedWords_ShouldSkipPhrasesStartingOrEndingWithThem(t *testing.T) {
+	index := NewReferenceIndex([]string{"the state of the art"}, WithExcludedWords(lists.EnglishStop))
+
+	assert.Equal(t, 0, index.phrases[1]['t']["the"])
+	assert.Equal(t, 0, index.phrases[2]['t']["the state"])
+	assert.Equal(t, 0, index.phrases[2]['s']["state of"])
+	assert.Equal(t, 1, index.phrases[1]['s']["state"])
+	assert.Equal(t, 1, index.phrases[1]['a']["art"])
+	assert.Equal(t, 1, index.phrases[4]['s']["state of the art"])
+}
+
+func TestExpandWithIndex_OnExcludedWords_ShouldIgnoreCommonWords(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "through thread", []string{}, []string{})
+	referenceText := []string{
+		"through the thread",
+		"through the lock",
+		"through the queue",
+		"through the thread",
+		"through the pool",
+		"the thread is started",
+		"the thread is released",
+	}
+
+	assert.Equal(t, []string{"through"}, ExpandWithIndex("thr", scope, NewReferenceIndex(referenceText)))
+	assert.Equal(t, []string{"thread"},
+		ExpandWithIndex("thr", scope, NewReferenceIndex(referenceText, WithExcludedWords(lists.EnglishStop))))
+}
+
+func TestExpand_OnExcludedWords_ShouldIgnoreCommonWords(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "through thread", []string{}, []string{})
+	referenceText := []string{
+		"through the thread",
+		"through the lock",
+		"through the queue",
+		"through the thread",
+		"through the pool",
+		"the thread is started",
+		"the thread is released",
+	}
+
+	assert.Equal(t, []string{"through"}, Expand("thr", scope, referenceText))
+	assert.Equal(t, []string{"thread"}, Expand("thr", scope, referenceText, WithExcludedWords(lists.EnglishStop)))
+
+	expansions, err := TryExpand("thr", scope, referenceText, WithExcludedWords(lists.EnglishStop))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"thread"}, expansions)
+}
+
+func TestScanMatches_OnReferenceText_ShouldMatchAsTheIndex(t *testing.T) {
+	referenceText := []string{
+		"check value validation",
+		"the graphical user interface value",
+		"graphical user interface extension",
+	}
+	index := NewReferenceIndex(referenceText)
+
+	for _, pttrn := range []pattern{
+		(&patternBuilder{}).kind("prefix").shortForm("val").build(),
+		(&patternBuilder{}).kind("acronym").shortForm("gui").build(),
+		(&patternBuilder{}).kind("word-combination").shortForm("usrint").build(),
+		(&patternBuilder{}).kind("prefix").shortForm("xt").build(),
+	} {
+		assert.Equal(t, index.matches(pttrn), scanMatches(pttrn, referenceText))
+	}
+}
+
+func TestMatches_OnAcronymLongerThanIndexedPhrases_ShouldScanTheReferenceText(t *testing.T) {
+	referenceText := []string{
+		"loads a portable network graphics image file format",
+		"portable network graphics image file format decoder",
+		"portable network graphics image format",
+	}
+	index := NewReferenceIndex(referenceText)
+	pttrn := (&patternBuilder{}).kind("acronym").shortForm("pngiff").build()
+	expected := map[string]int{"portable network graphics image file format": 2}
+
+	assert.Equal(t, expected, index.matches(pttrn))
+	assert.Equal(t, expected, scanMatches(pttrn, referenceText))
+
+	program := NewProgramScope(nil, referenceText, nil)
+	assert.Equal(t, "portable network graphics image file format", program.mostFrequentCandidate(pttrn,
+		[]string{"portable network graphics image file format", "public names get in first form"}))
+}
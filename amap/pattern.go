@@ -1,6 +1,13 @@
 package amap
 
-import "strings"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/eroatta/token/errs"
+)
 
 const (
 	singleWordGroup = "single-word"
@@ -30,11 +37,27 @@ var (
 	}
 )
 
+// ErrInvalidPattern indicates that the patterns for a short form can't be compiled.
+// It's the same error as errs.ErrInvalidPattern.
+var ErrInvalidPattern = errs.ErrInvalidPattern
+
+// maxCachedShortForms limits the number of short forms whose patterns are kept on the cache.
+const maxCachedShortForms = 10000
+
+var cache = &patternCache{entries: make(map[string]cachedPatterns)}
+
 type pattern struct {
 	group     string
 	kind      string
 	shortForm string
 	regex     string
+
+	// matcher looks for the pattern, declarationMatcher for "pattern sf", and exactMatcher for
+	// words or phrases fully matching the pattern.
+	matcher            *regexp.Regexp
+	declarationMatcher *regexp.Regexp
+	exactMatcher       *regexp.Regexp
+	err                error
 }
 
 type patternBuilder struct {
@@ -53,11 +76,69 @@ func (pb *patternBuilder) shortForm(sf string) *patternBuilder {
 	return pb
 }
 
+// build creates the pattern and compiles its regular expressions. Any compile error is kept on the pattern.
 func (pb *patternBuilder) build() pattern {
 	pb.pattern.regex = regexBuilders[pb.pattern.kind](pb.pattern.shortForm)
+	pb.pattern.matcher, pb.pattern.err = regexp.Compile(pb.pattern.regex)
+	if pb.pattern.err == nil {
+		pb.pattern.declarationMatcher, pb.pattern.err = regexp.Compile(pb.pattern.regex + "[ ]" +
+			regexp.QuoteMeta(pb.pattern.shortForm))
+	}
+	if pb.pattern.err == nil {
+		pb.pattern.exactMatcher, pb.pattern.err = regexp.Compile("^(?:" + pb.pattern.regex + ")$")
+	}
+	if pb.pattern.err != nil {
+		pb.pattern.err = fmt.Errorf("%w: %s pattern for %q: %v", ErrInvalidPattern, pb.pattern.kind,
+			pb.pattern.shortForm, pb.pattern.err)
+	}
+
 	return pb.pattern
 }
 
+type cachedPatterns struct {
+	patterns []pattern
+	err      error
+}
+
+// patternCache keeps the compiled patterns for each short form, so they're compiled only once.
+type patternCache struct {
+	mu      sync.RWMutex
+	entries map[string]cachedPatterns
+}
+
+// patterns retrieves the acronym, prefix, dropped letters and word combination patterns for the
+// short form, compiling them if they're not on the cache.
+func (pc *patternCache) patterns(shortForm string) ([]pattern, error) {
+	pc.mu.RLock()
+	cached, ok := pc.entries[shortForm]
+	pc.mu.RUnlock()
+	if ok {
+		return cached.patterns, cached.err
+	}
+
+	cached.patterns = []pattern{
+		(&patternBuilder{}).kind(acronymType).shortForm(shortForm).build(),
+		(&patternBuilder{}).kind(prefixType).shortForm(shortForm).build(),
+		(&patternBuilder{}).kind(droppedLettersType).shortForm(shortForm).build(),
+		(&patternBuilder{}).kind(wordCombinationType).shortForm(shortForm).build(),
+	}
+	for _, pttrn := range cached.patterns {
+		if pttrn.err != nil {
+			cached = cachedPatterns{err: pttrn.err}
+			break
+		}
+	}
+
+	pc.mu.Lock()
+	if len(pc.entries) >= maxCachedShortForms {
+		pc.entries = make(map[string]cachedPatterns)
+	}
+	pc.entries[shortForm] = cached
+	pc.mu.Unlock()
+
+	return cached.patterns, cached.err
+}
+
 func buildPrefixRegex(input string) string {
 	if len(input) == 0 {
 		return ""
@@ -68,7 +149,7 @@ func buildPrefixRegex(input string) string {
 	if input[0] == 'x' {
 		builder.WriteString("e?")
 	}
-	builder.WriteString(input)
+	builder.WriteString(regexp.QuoteMeta(input))
 	builder.WriteString("[a-z]+")
 
 	return builder.String()
@@ -86,7 +167,7 @@ func buildDroppedLettersRegex(input string) string {
 	}
 
 	for _, letter := range input {
-		builder.WriteRune(letter)
+		builder.WriteString(regexp.QuoteMeta(string(letter)))
 		builder.WriteString("[a-z]*")
 	}
 
@@ -104,10 +185,11 @@ func buildAcronymRegex(input string) string {
 		builder.WriteString("e?")
 	}
 
-	for i := 0; i < len(input); i++ {
-		builder.WriteByte(input[i])
+	letters := []rune(input)
+	for i, letter := range letters {
+		builder.WriteString(regexp.QuoteMeta(string(letter)))
 		builder.WriteString("[a-z]+")
-		if i < len(input)-1 {
+		if i < len(letters)-1 {
 			builder.WriteString("[ ]")
 		}
 	}
@@ -128,7 +210,7 @@ func buildWordCombinationRegex(input string) string {
 	}
 
 	for _, letter := range input {
-		builder.WriteRune(letter)
+		builder.WriteString(regexp.QuoteMeta(string(letter)))
 		builder.WriteString("[a-z]*?[ ]*?")
 	}
 	builder.WriteString("\\b")
@@ -1,6 +1,7 @@
 package amap
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -101,3 +102,62 @@ func TestBuild_OnPatternBuilderForWordCombination_ShouldReturnPatternWithRegex(t
 		})
 	}
 }
+
+func TestBuild_OnPatternBuilderWithMetacharacters_ShouldReturnPatternWithQuotedRegex(t *testing.T) {
+	cases := []struct {
+		name          string
+		kind          string
+		shortForm     string
+		expectedRegex string
+	}{
+		{"prefix", "prefix", "a+b", "\\ba\\+b[a-z]+"},
+		{"dropped_letters", "dropped-letters", "x[", "\\be?x[a-z]*\\[[a-z]*"},
+		{"acronym", "acronym", "a.b", "(a[a-z]+[ ]\\.[a-z]+[ ]b[a-z]+)"},
+		{"word_combination", "word-combination", "a(", "\\ba[a-z]*?[ ]*?\\([a-z]*?[ ]*?\\b"},
+	}
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pattern := (&patternBuilder{}).kind(fixture.kind).shortForm(fixture.shortForm).build()
+
+			assert.Equal(t, fixture.expectedRegex, pattern.regex)
+			assert.NoError(t, pattern.err)
+			assert.NotNil(t, pattern.matcher)
+			assert.NotNil(t, pattern.declarationMatcher)
+			assert.NotNil(t, pattern.exactMatcher)
+		})
+	}
+}
+
+func TestBuild_OnPatternBuilderWithInvalidShortForm_ShouldReturnPatternWithError(t *testing.T) {
+	pattern := (&patternBuilder{}).kind("prefix").shortForm("a\xffb").build()
+
+	assert.True(t, errors.Is(pattern.err, ErrInvalidPattern))
+	assert.Nil(t, pattern.matcher)
+}
+
+func TestPatterns_OnPatternCache_ShouldCompilePatternsOnce(t *testing.T) {
+	pc := &patternCache{entries: make(map[string]cachedPatterns)}
+
+	first, err := pc.patterns("gui")
+	assert.NoError(t, err)
+	second, err := pc.patterns("gui")
+	assert.NoError(t, err)
+
+	assert.Len(t, first, 4)
+	assert.Len(t, pc.entries, 1)
+	for i := range first {
+		assert.True(t, first[i].matcher == second[i].matcher)
+	}
+}
+
+func TestPatterns_OnPatternCacheWithInvalidShortForm_ShouldReturnError(t *testing.T) {
+	pc := &patternCache{entries: make(map[string]cachedPatterns)}
+
+	patterns, err := pc.patterns("a\xffb")
+
+	assert.True(t, errors.Is(err, ErrInvalidPattern))
+	assert.Nil(t, patterns)
+	_, err = pc.patterns("a\xffb")
+	assert.True(t, errors.Is(err, ErrInvalidPattern))
+}
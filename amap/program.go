@@ -0,0 +1,53 @@
+package amap
+
+// ProgramScope represents the program level on the scoped-approach for the AMAP expander, holding
+// the type declarations, comments and identifiers found on the whole program.
+// It's built once and shared between the token scopes of the program.
+type ProgramScope struct {
+	index *ReferenceIndex
+}
+
+// NewProgramScope creates a new program scope. Identifiers are split into their lower case words,
+// as in "jsonParserFactory". The options are used to build the index of the program text.
+func NewProgramScope(typeDeclarations []string, comments []string, identifiers []string, options ...IndexOption) *ProgramScope {
+	text := make([]string, 0, len(typeDeclarations)+len(comments)+len(identifiers))
+	text = append(text, typeDeclarations...)
+	text = append(text, comments...)
+	text = append(text, identifiers...)
+
+	return &ProgramScope{index: NewReferenceIndex(text, options...)}
+}
+
+// mostFrequentCandidate selects the candidate long form that most frequently matches the pattern
+// on the program, or an empty string if there's no program or no single most frequent candidate.
+func (ps *ProgramScope) mostFrequentCandidate(pttrn pattern, longForms []string) string {
+	if ps == nil {
+		return ""
+	}
+
+	matches := ps.index.matches(pttrn)
+
+	var best string
+	var bestCount, secondCount int
+	seen := make(map[string]bool, len(longForms))
+	for _, longForm := range longForms {
+		if seen[longForm] {
+			continue
+		}
+		seen[longForm] = true
+
+		count := matches[longForm]
+		switch {
+		case count > bestCount:
+			best, secondCount, bestCount = longForm, bestCount, count
+		case count > secondCount:
+			secondCount = count
+		}
+	}
+
+	if bestCount == 0 || bestCount == secondCount {
+		return ""
+	}
+
+	return best
+}
@@ -0,0 +1,61 @@
+package amap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMostFrequentCandidate_OnProgramScope_ShouldReturnMostFrequentCandidate(t *testing.T) {
+	cases := []struct {
+		name       string
+		shortForm  string
+		candidates []string
+		expected   string
+	}{
+		{"most_frequent_candidate", "val", []string{"value", "validation"}, "validation"},
+		{"non_candidate_words_ignored", "val", []string{"value", "valid"}, "value"},
+		{"tied_candidates", "par", []string{"parser", "parameter"}, ""},
+		{"no_matching_candidates", "val", []string{"valve", "valley"}, ""},
+	}
+
+	program := NewProgramScope(
+		[]string{"type validation struct", "type jsonParser struct"},
+		[]string{"validation rules", "returns the validation value", "parameter"},
+		[]string{"validationError", "paramValue"},
+	)
+
+	for _, fixture := range cases {
+		t.Run(fixture.name, func(t *testing.T) {
+			pttrn := (&patternBuilder{}).kind("prefix").shortForm(fixture.shortForm).build()
+
+			got := program.mostFrequentCandidate(pttrn, fixture.candidates)
+
+			assert.Equal(t, fixture.expected, got)
+		})
+	}
+}
+
+func TestMostFrequentCandidate_OnNilProgramScope_ShouldReturnEmptyWord(t *testing.T) {
+	var program *ProgramScope
+	pttrn := (&patternBuilder{}).kind("prefix").shortForm("val").build()
+
+	assert.Equal(t, "", program.mostFrequentCandidate(pttrn, []string{"value", "validation"}))
+}
+
+func TestExpand_OnAmapWithProgramScope_ShouldResolveAtProgramLevel(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "value validation", []string{}, []string{})
+	program := NewProgramScope([]string{"type validation struct"}, []string{"validation rules"}, []string{})
+
+	assert.Empty(t, Expand("val", scope, []string{}))
+	assert.Equal(t, []string{"validation"}, Expand("val", scope.WithProgram(program), []string{}))
+}
+
+func TestExpandWithIndex_OnAmap_ShouldUseIndexedReferenceText(t *testing.T) {
+	scope := NewTokenScope([]string{}, "", "value validation", []string{}, []string{})
+	index := NewReferenceIndex([]string{"big value", "small value", "tiny value", "check validation"})
+
+	assert.Equal(t, []string{"value"}, ExpandWithIndex("val", scope, index))
+	assert.Equal(t, Expand("val", scope, []string{"big value", "small value", "tiny value", "check validation"}),
+		ExpandWithIndex("val", scope, index))
+}
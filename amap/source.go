@@ -0,0 +1,258 @@
+package amap
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/eroatta/token/errs"
+)
+
+// ScopeFromSource parses the Go source code and builds the token scope for the identifier found at the
+// given byte offset, as ScopeFromFile does. The source can be provided as for parser.ParseFile.
+// An errs.ErrInvalidOffset error is returned if the offset is out of the source.
+func ScopeFromSource(filename string, src interface{}, offset int) (TokenScope, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return TokenScope{}, err
+	}
+
+	tokFile := fset.File(file.Pos())
+	if offset < 0 || offset > tokFile.Size() {
+		return TokenScope{}, fmt.Errorf("%w: %d is out of the source (%d bytes)", errs.ErrInvalidOffset, offset,
+			tokFile.Size())
+	}
+
+	return ScopeFromFile(fset, file, tokFile.Pos(offset)), nil
+}
+
+// ScopeFromFile builds the token scope for the identifier found at the given position of a file parsed
+// with comments. The method levels are taken from the function declaration holding the position:
+// * the declared variables and their types, the name, the comments and the statements of the function,
+// * the words, string literals and identifiers found on its body, except for the identifier itself.
+// The class levels are taken from the receiver type and its fields, when declared on the same file,
+// and the file levels from the comments, the package documentation and the imports of the file.
+func ScopeFromFile(fset *token.FileSet, file *ast.File, pos token.Pos) TokenScope {
+	builder := NewTokenScopeBuilder()
+
+	target := ""
+	var fn *ast.FuncDecl
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil || pos < node.Pos() || pos >= node.End() {
+			return false
+		}
+
+		switch n := node.(type) {
+		case *ast.FuncDecl:
+			fn = n
+		case *ast.Ident:
+			target = n.Name
+		}
+		return true
+	})
+
+	if fn != nil {
+		builder.MethodName(fn.Name.Name)
+		if fn.Doc != nil {
+			builder.MethodComments(fn.Doc.Text())
+		}
+		builder.VariableDeclarations(declarations(fn)...)
+
+		if fn.Body != nil {
+			bodyText, identifiers := bodyWords(fn.Body, target)
+			builder.MethodBodyText(bodyText).
+				Identifiers(identifiers...).
+				Statements(statements(fset, fn.Body)...)
+		}
+
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			receiver := typeName(fn.Recv.List[0].Type)
+			builder.ReceiverType(receiver).StructFields(structFields(file, receiver)...)
+		}
+	}
+
+	for _, group := range file.Comments {
+		if group == file.Doc || (fn != nil && (group == fn.Doc || group.Pos() >= fn.Pos() && group.End() <= fn.End())) {
+			continue
+		}
+		builder.FileComments(group.Text())
+	}
+	if file.Doc != nil {
+		builder.PackageComments(file.Doc.Text())
+	}
+
+	for _, imp := range file.Imports {
+		if path, err := strconv.Unquote(imp.Path.Value); err == nil {
+			builder.Imports(path)
+		}
+	}
+
+	return builder.Build()
+}
+
+// declarations retrieves the variables declared on the function, written as the words of their type
+// name followed by their name (i.e. "buffer buf" for a bytes.Buffer). The variables declared using a
+// short variable declaration are included only when their type is explicit, as in "new(bytes.Buffer)".
+func declarations(fn *ast.FuncDecl) []string {
+	var decls []string
+	add := func(typ ast.Expr, names []*ast.Ident) {
+		typeWords := strings.Join(words(baseTypeName(typ)), " ")
+		if typeWords == "" {
+			return
+		}
+		for _, name := range names {
+			decls = append(decls, typeWords+" "+strings.ToLower(name.Name))
+		}
+	}
+
+	for _, fields := range []*ast.FieldList{fn.Recv, fn.Type.Params, fn.Type.Results} {
+		if fields == nil {
+			continue
+		}
+		for _, field := range fields.List {
+			add(field.Type, field.Names)
+		}
+	}
+
+	if fn.Body == nil {
+		return decls
+	}
+
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.ValueSpec:
+			add(n.Type, n.Names)
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok {
+					add(valueType(n.Rhs[i]), []*ast.Ident{ident})
+				}
+			}
+		}
+		return true
+	})
+
+	return decls
+}
+
+// valueType retrieves the explicit type of a value, as in "T{}", "&T{}" or "new(T)".
+func valueType(value ast.Expr) ast.Expr {
+	switch v := value.(type) {
+	case *ast.CompositeLit:
+		return v.Type
+	case *ast.UnaryExpr:
+		if v.Op == token.AND {
+			return valueType(v.X)
+		}
+	case *ast.CallExpr:
+		if fun, ok := v.Fun.(*ast.Ident); ok && fun.Name == "new" && len(v.Args) == 1 {
+			return v.Args[0]
+		}
+	}
+
+	return nil
+}
+
+// typeName retrieves the name of a type, without pointers or type parameters, keeping the package
+// name for qualified types (i.e. "bytes.Buffer").
+func typeName(typ ast.Expr) string {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.SelectorExpr:
+		return typeName(t.X) + "." + t.Sel.Name
+	case *ast.ArrayType:
+		return typeName(t.Elt)
+	case *ast.IndexExpr:
+		return typeName(t.X)
+	}
+
+	return ""
+}
+
+// baseTypeName retrieves the name of a type without its package name (i.e. "Buffer" for "bytes.Buffer").
+func baseTypeName(typ ast.Expr) string {
+	name := typeName(typ)
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+// bodyWords retrieves the words of the identifiers and string literals found on the body, along with
+// the names of the identifiers. The target identifier is left out of both.
+func bodyWords(body *ast.BlockStmt, target string) (string, []string) {
+	var text []string
+	var identifiers []string
+	seen := make(map[string]bool)
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Ident:
+			if n.Name == target || n.Name == "_" {
+				return true
+			}
+			text = append(text, words(n.Name)...)
+			if !seen[n.Name] {
+				seen[n.Name] = true
+				identifiers = append(identifiers, n.Name)
+			}
+		case *ast.BasicLit:
+			if n.Kind == token.STRING {
+				if literal, err := strconv.Unquote(n.Value); err == nil {
+					text = append(text, words(literal)...)
+				}
+			}
+		}
+		return true
+	})
+
+	return strings.Join(text, " "), identifiers
+}
+
+// statements retrieves the source code of the simple statements found on the body.
+func statements(fset *token.FileSet, body *ast.BlockStmt) []string {
+	var stmts []string
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node.(type) {
+		case *ast.AssignStmt, *ast.DeclStmt, *ast.ExprStmt, *ast.ReturnStmt, *ast.SendStmt, *ast.IncDecStmt:
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, fset, node); err == nil {
+				stmts = append(stmts, buf.String())
+			}
+			return false
+		}
+		return true
+	})
+
+	return stmts
+}
+
+// structFields retrieves the field names of the struct type declared on the file with the given name.
+func structFields(file *ast.File, name string) []string {
+	var fields []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.TypeSpec)
+		if !ok || spec.Name.Name != name {
+			return true
+		}
+
+		if st, ok := spec.Type.(*ast.StructType); ok {
+			for _, field := range st.Fields.List {
+				for _, fieldName := range field.Names {
+					fields = append(fields, fieldName.Name)
+				}
+			}
+		}
+		return false
+	})
+
+	return fields
+}
@@ -0,0 +1,113 @@
+package amap
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/eroatta/token/errs"
+	"github.com/stretchr/testify/assert"
+)
+
+const scopeSource = `// Package sample sends requests.
+package sample
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// Client holds the connection settings.
+type Client struct {
+	baseURL string
+	timeout int
+}
+
+// sendRequest writes the request to the remote server.
+func (c *Client) sendRequest(req *http.Request) error {
+	buf := new(bytes.Buffer)
+	resp, err := do(req, "remote response")
+	return err
+}
+`
+
+func TestScopeFromSource_OnPositionInsideMethod_ShouldReturnEveryLevel(t *testing.T) {
+	offset := strings.Index(scopeSource, "buf :=")
+
+	scope, err := ScopeFromSource("sample.go", scopeSource, offset)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "send request", scope.methodName)
+	assert.Equal(t, []string{"sendRequest writes the request to the remote server.\n"}, scope.methodComments)
+	assert.Equal(t, []string{"client c", "request req", "buffer buf"}, scope.variableDeclarations)
+	assert.Equal(t, []string{"buf new bytes buffer", "resp err do req remote response", "return err"}, scope.statements)
+	assert.NotContains(t, strings.Fields(scope.methodBodyText), "buf")
+	assert.Contains(t, scope.methodBodyText, "remote response")
+	assert.Equal(t, "client", scope.receiverType)
+	assert.Equal(t, []string{"base url", "timeout"}, scope.structFields)
+	assert.Equal(t, []string{"Client holds the connection settings.\n"}, scope.fileComments)
+	assert.Equal(t, []string{"Package sample sends requests.\n"}, scope.packageComments)
+	assert.Equal(t, []string{"bytes", "http"}, scope.imports)
+}
+
+func TestScopeFromSource_OnPositionOutsideFunctions_ShouldReturnFileLevels(t *testing.T) {
+	offset := strings.Index(scopeSource, "baseURL")
+
+	scope, err := ScopeFromSource("sample.go", scopeSource, offset)
+
+	assert.NoError(t, err)
+	assert.Empty(t, scope.methodName)
+	assert.Empty(t, scope.variableDeclarations)
+	assert.Equal(t, 2, len(scope.fileComments))
+	assert.Equal(t, []string{"bytes", "http"}, scope.imports)
+}
+
+func TestScopeFromSource_OnInvalidSource_ShouldReturnError(t *testing.T) {
+	_, err := ScopeFromSource("sample.go", "package", 0)
+
+	assert.Error(t, err)
+}
+
+func TestScopeFromSource_OnOffsetOutOfSource_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int
+	}{
+		{"negative_offset", -1},
+		{"offset_after_end", len(scopeSource) + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ScopeFromSource("sample.go", scopeSource, tt.offset)
+
+			assert.True(t, errors.Is(err, errs.ErrInvalidOffset))
+		})
+	}
+}
+
+func TestExpand_OnScopeFromSource_ShouldExpandUsingTheSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		expected []string
+	}{
+		{"declaration", "buf", []string{"buffer"}},
+		{"body_text", "resp", []string{"response"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			offset := strings.Index(scopeSource, tt.token+",")
+			if offset < 0 {
+				offset = strings.Index(scopeSource, tt.token+" :=")
+			}
+			scope, err := ScopeFromSource("sample.go", scopeSource, offset)
+			assert.NoError(t, err)
+
+			got := Expand(tt.token, scope, []string{})
+
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -3,22 +3,59 @@
 package basic
 
 import (
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/expansion"
 	"github.com/eroatta/token/lists"
 )
 
 // DefaultExpansions contains the set of possible expansions included on the default configuration for Basic.
-var DefaultExpansions = expansion.NewSetBuilder().AddList(lists.Dictionary).Build()
+// The set is built the first time it's used.
+var DefaultExpansions = expansion.Lazy(func() expansion.Set { return NewExpansions(lists.Dictionary) })
+
+// NewExpansions creates a set of possible expansions for Basic from the given lists, such as the dictionary
+// for a chosen language or a mix of them.
+func NewExpansions(words ...lists.List) expansion.Set {
+	builder := expansion.NewSetBuilder()
+	for _, list := range words {
+		builder.AddList(list)
+	}
+
+	return builder.Build()
+}
 
 // Expand on Basic receives a token and returns an array of possible expansions.
 //
 // The Basic expansion algorithm builds a regular expression for the given token and
 // runs it against several lists built from the source code and natural words from
 // stop lists and dictionaries. It was proposed by Lawrie, Feild and Binkley.
+// When the sets hold weights (see expansion.WeightedSet), the expansions are ordered by decreasing weight.
+// Tokens whose regular expression can't be compiled have no expansions.
 func Expand(token string, srcWords expansion.Set, phrases map[string]string, defaultWords expansion.Set) []string {
+	expansions, _ := expand(token, srcWords, phrases, defaultWords)
+	return expansions
+}
+
+// TryExpand on Basic works as Expand, but returns an error when the token can't be expanded
+// because of the input: errs.ErrEmptyToken for an empty token, errs.ErrNilList when any of the
+// sets is nil, or errs.ErrInvalidPattern when the regular expression for the token can't be compiled.
+func TryExpand(token string, srcWords expansion.Set, phrases map[string]string, defaultWords expansion.Set) ([]string, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, errs.ErrEmptyToken
+	}
+
+	if srcWords == nil || defaultWords == nil {
+		return nil, errs.ErrNilList
+	}
+
+	return expand(token, srcWords, phrases, defaultWords)
+}
+
+func expand(token string, srcWords expansion.Set, phrases map[string]string, defaultWords expansion.Set) ([]string, error) {
 	token = strings.ToLower(token)
 
 	// build the search regex
@@ -26,23 +63,52 @@ func Expand(token string, srcWords expansion.Set, phrases map[string]string, def
 	pattern.WriteString("\\b")
 	for _, char := range token {
 		pattern.WriteString("[")
-		pattern.WriteRune(char)
+		pattern.WriteString(regexp.QuoteMeta(string(char)))
 		pattern.WriteString("]\\w*")
 	}
-	exp := regexp.MustCompile(pattern.String())
+	exp, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errs.ErrInvalidPattern, err)
+	}
 
 	// stage 1: should look on the words from the source code and then phrases lists
 	expansions := exp.FindAllString(srcWords.String(), -1)
 	if len(expansions) > 0 {
-		return expansions
+		return byWeight(expansions, srcWords), nil
 	}
 
 	if phrase := phrases[token]; phrase != "" {
-		return []string{strings.ReplaceAll(phrase, "-", " ")}
+		return []string{strings.ReplaceAll(phrase, "-", " ")}, nil
 	}
 
 	// stage 2: should look on the dictionary and stop lists
 	expansions = exp.FindAllString(defaultWords.String(), -1)
 
+	return byWeight(expansions, defaultWords), nil
+}
+
+// byWeight orders the expansions by decreasing weight, if the set holds weights for any of them.
+// Expansions with the same weight keep their original order.
+func byWeight(expansions []string, words expansion.Set) []string {
+	weighted, ok := words.(expansion.WeightedSet)
+	if !ok || !hasWeights(expansions, weighted) {
+		return expansions
+	}
+
+	sort.SliceStable(expansions, func(i, j int) bool {
+		return weighted.Weight(expansions[i]) > weighted.Weight(expansions[j])
+	})
+
 	return expansions
 }
+
+// hasWeights checks if any of the expansions has a weight on the set.
+func hasWeights(expansions []string, words expansion.WeightedSet) bool {
+	for _, e := range expansions {
+		if words.Weight(e) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -1,10 +1,13 @@
 package basic
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/lists"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -47,3 +50,71 @@ func BenchmarkBasicExpansion(b *testing.B) {
 		Expand("rdy", srcWords, phraseList, DefaultExpansions)
 	}
 }
+
+func TestNewExpansions_ShouldIncludeEveryList(t *testing.T) {
+	spanish := lists.NewBuilder().Add("configuracion").Build()
+	portuguese := lists.NewBuilder().Add("configuração").Build()
+
+	got := NewExpansions(spanish, portuguese)
+
+	assert.True(t, got.Contains("configuracion"))
+	assert.True(t, got.Contains("configuração"))
+}
+
+func TestExpand_OnBasicWithWeightedSet_ShouldOrderExpansionsByWeight(t *testing.T) {
+	frequencies := lists.NewWeightedBuilder().
+		Add("configure", 10).
+		Add("config", 5).
+		Add("configuration", 50).
+		Build()
+	defaultWords := expansion.NewSetBuilder().AddList(frequencies).Build()
+	srcWords := expansion.NewSetBuilder().Build()
+
+	got := Expand("cfg", srcWords, map[string]string{}, defaultWords)
+
+	assert.Equal(t, []string{"configuration", "configure", "config"}, got)
+}
+
+func TestExpand_OnBasicWithoutWeights_ShouldKeepTheExpansionsOrder(t *testing.T) {
+	defaultWords := expansion.NewSetBuilder().AddStrings("configure", "config", "configuration").Build()
+	srcWords := expansion.NewSetBuilder().Build()
+
+	got := Expand("cfg", srcWords, map[string]string{}, defaultWords)
+
+	assert.Equal(t, []string{"config", "configuration", "configure"}, got)
+}
+
+func TestTryExpand_OnBasic_ShouldReturnExpansionsOrError(t *testing.T) {
+	srcWords := expansion.NewSetBuilder().AddStrings("parser", "client").Build()
+	tests := []struct {
+		name         string
+		token        string
+		srcWords     expansion.Set
+		defaultWords expansion.Set
+		expected     []string
+		err          error
+	}{
+		{"valid_token", "prsr", srcWords, srcWords, []string{"parser"}, nil},
+		{"no_expansion", "xyz", srcWords, srcWords, nil, nil},
+		{"metacharacters", "]^", srcWords, srcWords, nil, nil},
+		{"empty_token", "", srcWords, srcWords, nil, errs.ErrEmptyToken},
+		{"nil_source_words", "prsr", nil, srcWords, nil, errs.ErrNilList},
+		{"nil_default_words", "prsr", srcWords, nil, nil, errs.ErrNilList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TryExpand(tt.token, tt.srcWords, map[string]string{}, tt.defaultWords)
+
+			assert.ElementsMatch(t, tt.expected, got)
+			assert.True(t, errors.Is(err, tt.err), fmt.Sprintf("unexpected error: %v", err))
+		})
+	}
+}
+
+func TestExpand_OnBasicWithMetacharacters_ShouldNotPanic(t *testing.T) {
+	srcWords := expansion.NewSetBuilder().AddStrings("parser").Build()
+
+	assert.NotPanics(t, func() { Expand("[", srcWords, map[string]string{}, srcWords) })
+	assert.NotPanics(t, func() { Expand("p\xffr", srcWords, map[string]string{}, srcWords) })
+}
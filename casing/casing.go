@@ -0,0 +1,200 @@
+// Package casing provides the functions to detect the case style of an identifier (i.e. camelCase or
+// snake_case), and to write its soft words back using any case style.
+package casing
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/eroatta/token/marker"
+	"github.com/eroatta/token/split"
+)
+
+var digitToUpperRegex = regexp.MustCompile("([0-9])([A-Z])")
+
+// Style represents a case style for identifiers.
+type Style int
+
+// Available case styles.
+const (
+	// Mixed is an identifier that combines several case styles, such as "get_UserName".
+	Mixed Style = iota
+	// Camel is an identifier such as "httpResponseCode". A single lower case word is considered camelCase.
+	Camel
+	// Pascal is an identifier such as "HttpResponseCode".
+	Pascal
+	// Snake is an identifier such as "http_response_code".
+	Snake
+	// ScreamingSnake is an identifier such as "HTTP_RESPONSE_CODE". A single upper case word is considered
+	// SCREAMING_SNAKE.
+	ScreamingSnake
+	// Kebab is an identifier such as "http-response-code".
+	Kebab
+)
+
+var styleNames = map[Style]string{
+	Mixed:          "mixed",
+	Camel:          "camelCase",
+	Pascal:         "PascalCase",
+	Snake:          "snake_case",
+	ScreamingSnake: "SCREAMING_SNAKE",
+	Kebab:          "kebab-case",
+}
+
+// String returns the name of the case style.
+func (s Style) String() string {
+	return styleNames[s]
+}
+
+// Detect detects the case style for the given identifier. Leading and trailing underscores
+// (i.e. "_private") are not considered.
+func Detect(identifier string) Style {
+	identifier = strings.Trim(identifier, "_")
+
+	hasUnderscore := strings.Contains(identifier, "_")
+	hasHyphen := strings.Contains(identifier, "-")
+	hasLower := strings.IndexFunc(identifier, unicode.IsLower) >= 0
+	hasUpper := strings.IndexFunc(identifier, unicode.IsUpper) >= 0
+
+	first, _ := utf8.DecodeRuneInString(identifier)
+
+	switch {
+	case identifier == "" || (hasUnderscore && hasHyphen):
+		return Mixed
+	case hasHyphen:
+		if hasUpper {
+			return Mixed
+		}
+		return Kebab
+	case !hasLower && hasUpper:
+		return ScreamingSnake
+	case hasUnderscore:
+		if hasUpper {
+			return Mixed
+		}
+		return Snake
+	case unicode.IsUpper(first):
+		return Pascal
+	default:
+		return Camel
+	}
+}
+
+// Option configures how identifiers are split and written.
+type Option func(*config)
+
+type config struct {
+	acronyms marker.Acronyms
+	preserve bool
+}
+
+func newConfig(options []Option) config {
+	conf := config{
+		acronyms: marker.KnownAcronyms,
+	}
+	for _, option := range options {
+		option(&conf)
+	}
+
+	return conf
+}
+
+// WithAcronyms sets the known acronyms, which are kept together when splitting an identifier.
+// By default, marker.KnownAcronyms is used.
+func WithAcronyms(acronyms marker.Acronyms) Option {
+	return func(c *config) {
+		c.acronyms = acronyms
+	}
+}
+
+// PreserveAcronyms writes the known acronyms on their canonical form (i.e. "HTTP" or "OAuth") when
+// writing a camelCase or PascalCase identifier, except for the first word of a camelCase identifier.
+func PreserveAcronyms() Option {
+	return func(c *config) {
+		c.preserve = true
+	}
+}
+
+// Words splits the identifier on its soft words, using its markers and case changes, and keeping the
+// known acronyms and digits together.
+func Words(identifier string, options ...Option) split.Result {
+	conf := newConfig(options)
+
+	marked := strings.ReplaceAll(identifier, "-", "_")
+	marked = marker.Protect(marked, conf.acronyms, onDigitToUpperCase,
+		marker.OnLowerToUpperCase, marker.OnUpperToLowerCase)
+
+	words := make([]string, 0)
+	for _, word := range marker.SplitBy(marked) {
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	return split.FromWords(identifier, words, "casing")
+}
+
+// Render writes the soft words using the given case style. Soft words are held in lower case, so the
+// case of a Mixed identifier such as "get_UserName" can't be restored: Mixed style is rendered as
+// snake_case.
+func Render(words split.Result, style Style, options ...Option) string {
+	conf := newConfig(options)
+
+	rendered := make([]string, len(words))
+	for i, part := range words {
+		word := part.Word
+		switch style {
+		case Camel:
+			if i == 0 {
+				word = strings.ToLower(word)
+			} else {
+				word = conf.capitalize(word)
+			}
+		case Pascal:
+			word = conf.capitalize(word)
+		case Snake, Kebab, Mixed:
+			word = strings.ToLower(word)
+		case ScreamingSnake:
+			word = strings.ToUpper(word)
+		}
+		rendered[i] = word
+	}
+
+	separator := ""
+	switch style {
+	case Snake, ScreamingSnake, Mixed:
+		separator = "_"
+	case Kebab:
+		separator = "-"
+	}
+
+	return strings.Join(rendered, separator)
+}
+
+// Convert writes the identifier using the given case style.
+func Convert(identifier string, style Style, options ...Option) string {
+	return Render(Words(identifier, options...), style, options...)
+}
+
+// onDigitToUpperCase applies markers on each digit-to-upper case combination, as digits are kept
+// together with the preceding letters.
+func onDigitToUpperCase(token string) string {
+	return digitToUpperRegex.ReplaceAllString(token, "${1}_$2")
+}
+
+// capitalize writes the first letter of the word in upper case, and the rest in lower case, unless
+// the word is a known acronym and acronyms must be preserved.
+func (c config) capitalize(word string) string {
+	if c.preserve {
+		if canonical, ok := c.acronyms.Canonical(word); ok {
+			return canonical
+		}
+	}
+
+	word = strings.ToLower(word)
+	first, size := utf8.DecodeRuneInString(word)
+
+	return string(unicode.ToUpper(first)) + word[size:]
+}
@@ -0,0 +1,98 @@
+package casing
+
+import (
+	"testing"
+
+	"github.com/eroatta/token/marker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect_ShouldReturnCaseStyle(t *testing.T) {
+	tests := []struct {
+		identifier string
+		want       Style
+	}{
+		{"httpResponseCode", Camel},
+		{"name", Camel},
+		{"HTTPResponseCode", Pascal},
+		{"HttpResponseCode", Pascal},
+		{"http_response_code", Snake},
+		{"_private_name", Snake},
+		{"utf8_decoder", Snake},
+		{"HTTP_RESPONSE_CODE", ScreamingSnake},
+		{"MAX", ScreamingSnake},
+		{"http-response-code", Kebab},
+		{"get_UserName", Mixed},
+		{"Http-Response", Mixed},
+		{"http_response-code", Mixed},
+		{"", Mixed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			got := Detect(tt.identifier)
+
+			assert.Equal(t, tt.want, got, "got: %v", got)
+		})
+	}
+}
+
+func TestStyle_ShouldReturnName(t *testing.T) {
+	assert.Equal(t, "camelCase", Camel.String())
+	assert.Equal(t, "SCREAMING_SNAKE", ScreamingSnake.String())
+	assert.Equal(t, "mixed", Mixed.String())
+}
+
+func TestWords_ShouldSplitIdentifierKeepingAcronymsAndDigits(t *testing.T) {
+	tests := []struct {
+		identifier string
+		want       []string
+	}{
+		{"HTTPResponseCode", []string{"http", "response", "code"}},
+		{"parseURLs", []string{"parse", "urls"}},
+		{"http-response-code", []string{"http", "response", "code"}},
+		{"__private__name", []string{"private", "name"}},
+		{"base64Encode", []string{"base64", "encode"}},
+		{"UTF8Decoder", []string{"utf8", "decoder"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.identifier, func(t *testing.T) {
+			got := Words(tt.identifier)
+
+			assert.Equal(t, tt.want, got.Words())
+		})
+	}
+}
+
+func TestConvert_ShouldWriteIdentifierOnStyle(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		style      Style
+		options    []Option
+		want       string
+	}{
+		{"to_snake", "HTTPResponseCode", Snake, nil, "http_response_code"},
+		{"to_pascal", "HTTPResponseCode", Pascal, nil, "HttpResponseCode"},
+		{"to_pascal_preserving_acronyms", "http_response_code", Pascal, []Option{PreserveAcronyms()}, "HTTPResponseCode"},
+		{"to_camel", "HTTP_RESPONSE_CODE", Camel, nil, "httpResponseCode"},
+		{"to_camel_preserving_acronyms", "response-http-code", Camel, []Option{PreserveAcronyms()}, "responseHTTPCode"},
+		{"to_camel_preserving_mixed_case_acronyms", "new_oauth_token", Camel, []Option{PreserveAcronyms()}, "newOAuthToken"},
+		{"to_camel_with_first_acronym", "HTTPResponse", Camel, []Option{PreserveAcronyms()}, "httpResponse"},
+		{"to_screaming_snake", "httpResponseCode", ScreamingSnake, nil, "HTTP_RESPONSE_CODE"},
+		{"to_kebab", "HttpResponseCode", Kebab, nil, "http-response-code"},
+		{"to_mixed", "HttpResponseCode", Mixed, nil, "http_response_code"},
+		{"to_mixed_from_mixed", "get_UserName", Mixed, nil, "get_user_name"},
+		{"with_custom_acronyms", "CFGFile", Snake, []Option{WithAcronyms(marker.NewAcronyms("cfg"))}, "cfg_file"},
+		{"empty", "", Snake, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Convert(tt.identifier, tt.style, tt.options...)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Command abbrevcheck reports the long forms written using different short forms across a module, such
+// as "cfg", "conf" and "configuration", along with the locations where each form is used.
+//
+// Usage:
+//
+//	abbrevcheck [-splitter conserv] [-expander amap] [-format text] [dir]
+//
+// The directory defaults to the current one. The report is written as plain text or as JSON.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/eroatta/token/consistency"
+)
+
+func main() {
+	splitter := flag.String("splitter", "conserv", "algorithm used to split identifiers: conserv or greedy")
+	expander := flag.String("expander", "amap", "algorithm used to expand soft words: amap or basic")
+	format := flag.String("format", "text", "output format: text or json")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	if *format != "text" && *format != "json" {
+		log.Fatalf("unsupported format: %s", *format)
+	}
+
+	report, err := consistency.CheckDir(dir, consistency.WithSplitter(*splitter), consistency.WithExpander(*expander))
+	if err != nil {
+		log.Fatalf("checking %s: %v", dir, err)
+	}
+
+	if *format == "json" {
+		err = report.WriteJSON(os.Stdout)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,22 @@
+// Command identlint reports poorly named identifiers on Go packages: unknown words, abbreviations,
+// inconsistent abbreviations and single letter names.
+//
+// Usage:
+//
+//	identlint [-checks unknown,abbreviation,inconsistent,short] [-splitter conserv] [-expander amap] [-vocabulary file] [-allow w,r] [packages]
+//
+// It can also be run by go vet:
+//
+//	go vet -vettool=$(which identlint) ./...
+//
+// The -fix flag applies the suggested renames.
+package main
+
+import (
+	"github.com/eroatta/token/lint"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(lint.Analyzer)
+}
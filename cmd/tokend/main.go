@@ -0,0 +1,67 @@
+// Command tokend serves the splitting and expansion algorithms over HTTP, using JSON requests, and
+// optionally over gRPC, using the TokenService defined on package rpc.
+//
+// Usage:
+//
+//	tokend [-addr :8080] [-grpc-addr :9090] [-local-frequencies file] [-global-frequencies file] [-similarity file]
+//
+// Frequency table files hold a token and its occurrences on each line, and are required by Samurai; both
+// the local and the global tables must be given.
+// The similarity file holds two words and their similarity score on each line, and it's required by GenTest.
+package main
+
+import (
+	"flag"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/eroatta/token/rpc"
+	"github.com/eroatta/token/service"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	grpcAddr := flag.String("grpc-addr", "", "address to listen on for gRPC requests, disabled if empty")
+	localFrequencies := flag.String("local-frequencies", "", "local frequency table file for Samurai")
+	globalFrequencies := flag.String("global-frequencies", "", "global frequency table file for Samurai")
+	similarity := flag.String("similarity", "", "similarity scores file for GenTest")
+	maxTokens := flag.Int("max-tokens", service.DefaultMaxTokens, "maximum number of tokens on a request")
+	flag.Parse()
+
+	options, err := service.LoadTables(*localFrequencies, *globalFrequencies, *similarity)
+	if err != nil {
+		log.Fatal(err)
+	}
+	options = append(options, service.WithMaxTokens(*maxTokens))
+
+	start := time.Now()
+	svc := service.New(options...)
+	log.Printf("resources loaded in %v", time.Since(start))
+
+	if *grpcAddr != "" {
+		listener, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			log.Fatalf("listening for gRPC requests: %v", err)
+		}
+
+		grpcServer := grpc.NewServer()
+		rpc.Register(grpcServer, svc)
+		go func() {
+			log.Printf("listening for gRPC requests on %s", *grpcAddr)
+			log.Fatal(grpcServer.Serve(listener))
+		}()
+	}
+
+	server := &http.Server{
+		Addr:         *addr,
+		Handler:      service.NewHandler(svc),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+	}
+
+	log.Printf("listening on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
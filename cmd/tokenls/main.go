@@ -0,0 +1,43 @@
+// Command tokenls runs a Language Server Protocol server over the standard input and output, giving
+// insight on the identifiers of Go documents: hovering an identifier shows its split and expansion, and
+// a code action renames an abbreviated identifier to its expanded form.
+//
+// Usage:
+//
+//	tokenls [-splitter greedy] [-expander amap] [-local-frequencies file] [-global-frequencies file] [-similarity file]
+//
+// Frequency table files hold a token and its occurrences on each line, and are required by Samurai; both
+// the local and the global tables must be given.
+// The similarity file holds two words and their similarity score on each line, and it's required by GenTest.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/eroatta/token/lsp"
+	"github.com/eroatta/token/service"
+)
+
+func main() {
+	splitter := flag.String("splitter", service.Greedy, "algorithm used to split identifiers: conserv, greedy or samurai")
+	expander := flag.String("expander", service.AMAP, "algorithm used to expand identifiers: amap or gentest")
+	localFrequencies := flag.String("local-frequencies", "", "local frequency table file for Samurai")
+	globalFrequencies := flag.String("global-frequencies", "", "global frequency table file for Samurai")
+	similarity := flag.String("similarity", "", "similarity scores file for GenTest")
+	flag.Parse()
+
+	// the standard output is used by the protocol
+	log.SetOutput(os.Stderr)
+
+	options, err := service.LoadTables(*localFrequencies, *globalFrequencies, *similarity)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	server := lsp.NewServer(service.New(options...), lsp.WithSplitter(*splitter), lsp.WithExpander(*expander))
+	if err := server.Serve(os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
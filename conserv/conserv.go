@@ -5,22 +5,41 @@ package conserv
 import (
 	"strings"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/marker"
 )
 
 // Separator specifies the current separator.
 var Separator string = " "
 
+// Acronyms specifies the known acronyms, which are kept together when splitting a token.
+var Acronyms = marker.KnownAcronyms
+
 // Split on Conserv receives a token and returns an array of hard/soft words,
 // split by:
 // * Underscores
 // * Numbers
 // * CamelCase.
-func Split(token string) string {
-	processedToken := marker.OnDigits(token)
-	processedToken = marker.OnLowerToUpperCase(processedToken)
-	processedToken = marker.OnUpperToLowerCase(processedToken)
+//
+// Known acronyms, including their plural and mixed case forms, are kept together (i.e. "parseURLs"
+// is split as "parse urls" and "MD5Hash" as "md5 hash").
+//
+// The behaviour can be customized using options, such as WithDigitPolicy.
+func Split(token string, options ...Option) string {
+	conf := newConfig(options)
+
+	processedToken := marker.Protect(token, Acronyms,
+		conf.digits, marker.OnLowerToUpperCase, marker.OnUpperToLowerCase)
 	processedToken = strings.ToLower(processedToken)
 
 	return strings.Join(marker.SplitBy(processedToken), Separator)
 }
+
+// TrySplit on Conserv works as Split, but returns errs.ErrEmptyToken when the token is empty.
+func TrySplit(token string, options ...Option) (string, error) {
+	if strings.TrimSpace(token) == "" {
+		return "", errs.ErrEmptyToken
+	}
+
+	return Split(token, options...), nil
+}
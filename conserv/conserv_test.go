@@ -1,8 +1,11 @@
 package conserv
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/eroatta/token/errs"
+	"github.com/eroatta/token/marker"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -22,6 +25,17 @@ func TestSplit_OnConserv_ShouldReturnValidSplits(t *testing.T) {
 		{"mySql", "my sql"},
 		{"mySQl", "my s ql"},
 		{"9999", "9999"},
+		{"HTTPServer", "http server"},
+		{"parseURLs", "parse urls"},
+		{"userIDs", "user ids"},
+		{"userIDsList", "user ids list"},
+		{"iOSVersion", "ios version"},
+		{"OAuthToken", "oauth token"},
+		{"newOAuthToken", "new oauth token"},
+		{"MD5Hash", "md5 hash"},
+		{"UTF8Decoder", "utf8 decoder"},
+		{"get_URLs", "get urls"},
+		{"IDENTITYValue", "identity value"},
 		{"", ""},
 	}
 
@@ -37,3 +51,48 @@ func BenchmarkConservSplitting(b *testing.B) {
 		Split("spongebob_squarePants")
 	}
 }
+
+func TestSplit_OnConservWithDigitPolicies_ShouldHandleDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy marker.DigitPolicy
+		token  string
+		want   string
+	}{
+		{"split_digits", marker.SplitDigits, "parseInt64Value", "parse int 64 value"},
+		{"keep_digits", marker.KeepDigits, "parseInt64Value", "parse int64value"},
+		{"glue_digits", marker.GlueDigits(marker.DigitWords), "parseInt64Value", "parse int64 value"},
+		{"glue_digits_unknown_word", marker.GlueDigits(marker.DigitWords), "leto2nd", "leto 2 nd"},
+		{"glue_digits_lower_case_word", marker.GlueDigits(marker.DigitWords), "base64_encode", "base64 encode"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.token, WithDigitPolicy(tt.policy))
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTrySplit_OnConserv_ShouldReturnSplitsOrError(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		expected string
+		err      error
+	}{
+		{"valid_token", "httpResponse", "http response", nil},
+		{"empty_token", "", "", errs.ErrEmptyToken},
+		{"blank_token", "  ", "", errs.ErrEmptyToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TrySplit(tt.token)
+
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package conserv
+
+import "github.com/eroatta/token/marker"
+
+// Option configures the behaviour of Conserv.
+type Option func(*config)
+
+type config struct {
+	digits marker.DigitPolicy
+}
+
+func newConfig(options []Option) config {
+	conf := config{
+		digits: marker.SplitDigits,
+	}
+	for _, option := range options {
+		option(&conf)
+	}
+
+	return conf
+}
+
+// WithDigitPolicy sets the policy used to split letters and digits.
+// By default, the marker.SplitDigits policy is used.
+func WithDigitPolicy(policy marker.DigitPolicy) Option {
+	return func(c *config) {
+		if policy != nil {
+			c.digits = policy
+		}
+	}
+}
@@ -0,0 +1,211 @@
+// Package consistency reports the long forms written using different short forms across a codebase,
+// such as "cfg", "conf" and "configuration". Every declared identifier is split on its soft words, and
+// the soft words are expanded using AMAP or Basic; the soft words expanded with high confidence, which
+// means that a single expansion was found, are grouped by long form. As for the lint package, the
+// expansions holding several words are accepted only as acronyms, otherwise the only word abbreviated
+// by the soft word is taken, so "cfg" is expanded to "configuration" instead of the method name
+// "load configuration".
+package consistency
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/eroatta/token/internal/naming"
+	"github.com/eroatta/token/lists"
+)
+
+// ErrUnknownAlgorithm indicates that the splitter or the expander set for the report is not supported.
+var ErrUnknownAlgorithm = naming.ErrUnknownAlgorithm
+
+// Option sets a setting for the report.
+type Option func(*config)
+
+type config struct {
+	algorithms naming.Algorithms
+}
+
+func newConfig(options []Option) config {
+	conf := config{algorithms: naming.Default()}
+	for _, option := range options {
+		option(&conf)
+	}
+
+	return conf
+}
+
+// WithSplitter sets the algorithm used to split identifiers: conserv (the default) or greedy.
+func WithSplitter(algorithm string) Option {
+	return func(c *config) {
+		c.algorithms.Splitter = algorithm
+	}
+}
+
+// WithExpander sets the algorithm used to expand soft words: amap (the default) or basic.
+func WithExpander(algorithm string) Option {
+	return func(c *config) {
+		c.algorithms.Expander = algorithm
+	}
+}
+
+// sourceFile is a parsed Go file, along with the name used on the report.
+type sourceFile struct {
+	name string
+	file *ast.File
+}
+
+// CheckDir builds the report for every Go file found on the directory and its subdirectories.
+// An ErrUnknownAlgorithm error is returned if the splitter or the expander is not supported.
+// Hidden directories, "vendor" and "testdata" directories are skipped, and file names on the report
+// are relative to the directory.
+func CheckDir(root string, options ...Option) (*Report, error) {
+	conf := newConfig(options)
+	if err := conf.algorithms.Validate(); err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []sourceFile
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			name = path
+		}
+		files = append(files, sourceFile{name: filepath.ToSlash(name), file: file})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return check(fset, files, conf), nil
+}
+
+// CheckSource builds the report for the given Go source code. The source can be provided as for
+// parser.ParseFile. An ErrUnknownAlgorithm error is returned as for CheckDir.
+func CheckSource(filename string, src interface{}, options ...Option) (*Report, error) {
+	conf := newConfig(options)
+	if err := conf.algorithms.Validate(); err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	return check(fset, []sourceFile{{name: filename, file: file}}, conf), nil
+}
+
+// check groups the soft words of the declared identifiers by long form. The soft words written on their
+// long form are also grouped, so they are reported along with the short forms.
+func check(fset *token.FileSet, files []sourceFile, conf config) *Report {
+	parsed := make([]*ast.File, len(files))
+	for i, src := range files {
+		parsed[i] = src.file
+	}
+	expand := conf.algorithms.NewExpander(fset, parsed)
+
+	var abbreviated []occurrence
+	written := make(map[string][]Location)
+	for _, src := range files {
+		for _, ident := range declaredNames(src.file) {
+			position := fset.Position(ident.Pos())
+			location := Location{File: src.name, Line: position.Line, Column: position.Column, Identifier: ident.Name}
+
+			seen := make(map[string]bool)
+			for _, part := range conf.algorithms.Split(ident.Name) {
+				word := part.Word
+				if seen[word] || len(word) < 2 || lists.Stop.Contains(word) || strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+					continue
+				}
+				seen[word] = true
+
+				if lists.Dictionary.Contains(word) && !lists.KnownAbbreviations.Contains(word) {
+					written[word] = append(written[word], location)
+					continue
+				}
+
+				if longForm := expand(src.file, ident, word); longForm != "" {
+					abbreviated = append(abbreviated, occurrence{shortForm: word, longForm: longForm, location: location})
+				}
+			}
+		}
+	}
+
+	return newReport(abbreviated, written)
+}
+
+// declaredNames retrieves the identifiers declared on the file: types, functions, methods, fields,
+// parameters, constants and variables.
+func declaredNames(file *ast.File) []*ast.Ident {
+	var names []*ast.Ident
+	add := func(idents ...*ast.Ident) {
+		for _, ident := range idents {
+			if ident != nil && ident.Name != "_" {
+				names = append(names, ident)
+			}
+		}
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.FuncDecl:
+			add(n.Name)
+		case *ast.TypeSpec:
+			add(n.Name)
+		case *ast.Field:
+			add(n.Names...)
+		case *ast.ValueSpec:
+			add(n.Names...)
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE {
+				for _, lhs := range n.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						add(ident)
+					}
+				}
+			}
+		case *ast.RangeStmt:
+			if n.Tok == token.DEFINE {
+				for _, expr := range []ast.Expr{n.Key, n.Value} {
+					if ident, ok := expr.(*ast.Ident); ok {
+						add(ident)
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	return names
+}
@@ -0,0 +1,190 @@
+package consistency
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const configSource = `package config
+
+// Configuration holds the settings.
+type Configuration struct{}
+
+func load(cfg *Configuration) {}
+
+func reload(cfg *Configuration) {}
+
+func store(conf *Configuration) {}
+
+func defaultConfiguration() *Configuration { return nil }
+
+func flush(buf *bytes.Buffer) {}
+`
+
+const documentedSource = `package config
+
+// loadConfiguration reads the configuration from the path.
+func loadConfiguration(cfgPath string) {}
+
+// parseConfiguration parses the configuration text.
+func parseConfiguration(confText string) {}
+
+// applyConfiguration applies the configuration with the given name.
+func applyConfiguration(configName string) {}
+`
+
+func TestCheckSource_OnInconsistentShortForms_ShouldReturnClusters(t *testing.T) {
+	report, err := CheckSource("config.go", configSource)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Cluster{
+		{LongForm: "configuration", Count: 5, Variants: []Variant{
+			{Form: "cfg", Count: 2, Locations: []Location{
+				{File: "config.go", Line: 6, Column: 11, Identifier: "cfg"},
+				{File: "config.go", Line: 8, Column: 13, Identifier: "cfg"},
+			}},
+			{Form: "configuration", Count: 2, Locations: []Location{
+				{File: "config.go", Line: 4, Column: 6, Identifier: "Configuration"},
+				{File: "config.go", Line: 12, Column: 6, Identifier: "defaultConfiguration"},
+			}},
+			{Form: "conf", Count: 1, Locations: []Location{
+				{File: "config.go", Line: 10, Column: 12, Identifier: "conf"},
+			}},
+		}},
+	}, report.Clusters)
+}
+
+func TestCheckSource_OnEachExpander_ShouldFindConfigurationCluster(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      string
+		options  []Option
+		expected []string
+	}{
+		{"amap", configSource, nil, []string{"cfg", "configuration", "conf"}},
+		{"basic", configSource, []Option{WithExpander("basic")}, []string{"cfg", "configuration", "conf"}},
+		{"amap_on_documented_functions", documentedSource, nil, []string{"configuration", "cfg", "conf", "config"}},
+		{"basic_on_documented_functions", documentedSource, []Option{WithExpander("basic")},
+			[]string{"configuration", "cfg", "conf", "config"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := CheckSource("config.go", tt.src, tt.options...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(report.Clusters))
+			assert.Equal(t, "configuration", report.Clusters[0].LongForm)
+			assert.Equal(t, tt.expected, forms(report.Clusters[0]))
+		})
+	}
+}
+
+func TestCheckSource_OnInvalidSource_ShouldReturnError(t *testing.T) {
+	_, err := CheckSource("config.go", "package")
+
+	assert.Error(t, err)
+}
+
+func TestCheckSource_OnUnknownAlgorithms_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+	}{
+		{"unknown_splitter", []Option{WithSplitter("samurai")}},
+		{"unknown_expander", []Option{WithExpander("gentest")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := CheckSource("config.go", configSource, tt.options...)
+			assert.True(t, errors.Is(err, ErrUnknownAlgorithm))
+
+			_, err = CheckDir(t.TempDir(), tt.options...)
+			assert.True(t, errors.Is(err, ErrUnknownAlgorithm))
+		})
+	}
+}
+
+func TestCheckDir_OnDirectory_ShouldUseRelativeNamesAndSkipTestdata(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"config/load.go":    "package config\n\n// Configuration holds the settings.\ntype Configuration struct{}\n\nfunc load(cfg *Configuration) {}\n",
+		"store/store.go":    "package store\n\nfunc store(conf *config.Configuration) {}\n",
+		"testdata/skip.go":  "package skip\n\nfunc skip(cnf *config.Configuration) {}\n",
+		"config/README.txt": "cfg",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	report, err := CheckDir(root)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(report.Clusters))
+	cluster := report.Clusters[0]
+	assert.Equal(t, "configuration", cluster.LongForm)
+	assert.Equal(t, []string{"cfg", "conf", "configuration"}, forms(cluster))
+	assert.Equal(t, "store/store.go", cluster.Variants[1].Locations[0].File)
+}
+
+func TestCheckDir_OnMissingDirectory_ShouldReturnError(t *testing.T) {
+	_, err := CheckDir(filepath.Join(t.TempDir(), "missing"))
+
+	assert.Error(t, err)
+}
+
+func TestWriteText_OnReport_ShouldListFormsAndLocations(t *testing.T) {
+	report, _ := CheckSource("config.go", configSource)
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.WriteText(&buf))
+
+	expected := `configuration: 5 occurrences, 3 forms
+  cfg (2)
+    config.go:6:11 cfg
+    config.go:8:13 cfg
+  configuration (2)
+    config.go:4:6 Configuration
+    config.go:12:6 defaultConfiguration
+  conf (1)
+    config.go:10:12 conf
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestWriteText_OnEmptyReport_ShouldReportNoInconsistencies(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, (&Report{}).WriteText(&buf))
+
+	assert.Equal(t, "no inconsistencies found\n", buf.String())
+}
+
+func TestWriteJSON_OnReport_ShouldRoundTrip(t *testing.T) {
+	report, _ := CheckSource("config.go", configSource)
+
+	var buf bytes.Buffer
+	assert.NoError(t, report.WriteJSON(&buf))
+
+	var decoded Report
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	assert.Equal(t, *report, decoded)
+	assert.Contains(t, buf.String(), `"long_form": "configuration"`)
+}
+
+func forms(cluster Cluster) []string {
+	names := make([]string, 0, len(cluster.Variants))
+	for _, variant := range cluster.Variants {
+		names = append(names, variant.Form)
+	}
+
+	return names
+}
@@ -0,0 +1,129 @@
+package consistency
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Location is a position on the source code where an identifier holding a form is declared.
+type Location struct {
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Column     int    `json:"column"`
+	Identifier string `json:"identifier"`
+}
+
+// Variant is one of the forms used to write a long form, which can be the long form itself.
+type Variant struct {
+	Form      string     `json:"form"`
+	Count     int        `json:"count"`
+	Locations []Location `json:"locations"`
+}
+
+// Cluster holds the forms used to write a long form, sorted by decreasing count.
+type Cluster struct {
+	LongForm string    `json:"long_form"`
+	Count    int       `json:"count"`
+	Variants []Variant `json:"variants"`
+}
+
+// Report holds the inconsistency clusters found on the codebase: the long forms written using more
+// than one form, sorted by decreasing count.
+type Report struct {
+	Clusters []Cluster `json:"clusters"`
+}
+
+// occurrence is a short form expanded to a long form, found on a location.
+type occurrence struct {
+	shortForm string
+	longForm  string
+	location  Location
+}
+
+// newReport groups the short forms by long form. The long forms written in full are added as a
+// further variant. Only the long forms written using more than one form are reported.
+func newReport(abbreviated []occurrence, written map[string][]Location) *Report {
+	forms := make(map[string]map[string][]Location)
+	for _, occ := range abbreviated {
+		if forms[occ.longForm] == nil {
+			forms[occ.longForm] = make(map[string][]Location)
+		}
+		forms[occ.longForm][occ.shortForm] = append(forms[occ.longForm][occ.shortForm], occ.location)
+	}
+
+	report := &Report{Clusters: []Cluster{}}
+	for longForm, variants := range forms {
+		if locations, ok := written[longForm]; ok {
+			variants[longForm] = locations
+		}
+		if len(variants) < 2 {
+			continue
+		}
+
+		cluster := Cluster{LongForm: longForm}
+		for form, locations := range variants {
+			cluster.Variants = append(cluster.Variants, Variant{Form: form, Count: len(locations), Locations: locations})
+			cluster.Count += len(locations)
+		}
+		sort.Slice(cluster.Variants, func(i, j int) bool {
+			if cluster.Variants[i].Count != cluster.Variants[j].Count {
+				return cluster.Variants[i].Count > cluster.Variants[j].Count
+			}
+			return cluster.Variants[i].Form < cluster.Variants[j].Form
+		})
+		report.Clusters = append(report.Clusters, cluster)
+	}
+
+	sort.Slice(report.Clusters, func(i, j int) bool {
+		if report.Clusters[i].Count != report.Clusters[j].Count {
+			return report.Clusters[i].Count > report.Clusters[j].Count
+		}
+		return report.Clusters[i].LongForm < report.Clusters[j].LongForm
+	})
+
+	return report
+}
+
+// WriteText writes the report as plain text, listing the forms of each cluster along with the
+// locations where they are used.
+func (r *Report) WriteText(w io.Writer) error {
+	if len(r.Clusters) == 0 {
+		_, err := fmt.Fprintln(w, "no inconsistencies found")
+		return err
+	}
+
+	for i, cluster := range r.Clusters {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(w, "%s: %d occurrences, %d forms\n", cluster.LongForm, cluster.Count,
+			len(cluster.Variants)); err != nil {
+			return err
+		}
+		for _, variant := range cluster.Variants {
+			if _, err := fmt.Fprintf(w, "  %s (%d)\n", variant.Form, variant.Count); err != nil {
+				return err
+			}
+			for _, loc := range variant.Locations {
+				if _, err := fmt.Fprintf(w, "    %s:%d:%d %s\n", loc.File, loc.Line, loc.Column, loc.Identifier); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// WriteJSON writes the report as JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(r)
+}
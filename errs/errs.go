@@ -0,0 +1,33 @@
+// Package errs declares the errors returned by the error-returning variants of the splitting and
+// expansion functions, such as conserv.TrySplit or amap.TryExpand.
+//
+// Errors can be wrapped with additional details, so they should be checked using errors.Is:
+//
+//	expansions, err := amap.TryExpand(token, scope, reference)
+//	if errors.Is(err, errs.ErrEmptyToken) {
+//		// ...
+//	}
+package errs
+
+import "errors"
+
+var (
+	// ErrEmptyToken indicates that the token to split or expand is empty.
+	ErrEmptyToken = errors.New("empty token")
+
+	// ErrNilCalculator indicates that no similarity calculator was provided.
+	ErrNilCalculator = errors.New("nil similarity calculator")
+
+	// ErrInvalidPattern indicates that the patterns built for a token can't be compiled.
+	ErrInvalidPattern = errors.New("invalid pattern")
+
+	// ErrEmptyContext indicates that the context for the token, such as its frequency tables or
+	// its context words, is missing or empty.
+	ErrEmptyContext = errors.New("empty context")
+
+	// ErrNilList indicates that a required list or expansion set is nil.
+	ErrNilList = errors.New("nil list")
+
+	// ErrInvalidOffset indicates that the offset of the token is out of the source code.
+	ErrInvalidOffset = errors.New("invalid offset")
+)
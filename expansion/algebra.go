@@ -0,0 +1,45 @@
+package expansion
+
+import "github.com/eroatta/token/lists"
+
+// Union creates a new set with the expansions contained on any of the given sets.
+func Union(sets ...Set) Set {
+	return newSet(lists.Union(wordsOf(sets)...))
+}
+
+// Intersect creates a new set with the expansions contained on every given set.
+func Intersect(first Set, others ...Set) Set {
+	return newSet(lists.Intersect(words(first), wordsOf(others)...))
+}
+
+// Difference creates a new set with the expansions contained on the first set, but not on the others.
+func Difference(first Set, others ...Set) Set {
+	return newSet(lists.Difference(words(first), wordsOf(others)...))
+}
+
+// Filter creates a new set with the expansions of the given set that satisfy the predicate.
+func Filter(s Set, keep func(string) bool) Set {
+	return newSet(lists.Filter(words(s), keep))
+}
+
+func wordsOf(sets []Set) []lists.List {
+	found := make([]lists.List, len(sets))
+	for i, s := range sets {
+		found[i] = words(s)
+	}
+
+	return found
+}
+
+// words retrieves the underlying list of words for a set, avoiding the copy of the words for
+// the sets provided by this package.
+func words(s Set) lists.List {
+	switch typed := s.(type) {
+	case *set:
+		return typed.words
+	case *lazySet:
+		return words(typed.get())
+	default:
+		return lists.NewBuilder().Add(s.Array()...).Build()
+	}
+}
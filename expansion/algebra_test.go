@@ -0,0 +1,46 @@
+package expansion
+
+import (
+	"testing"
+
+	"github.com/eroatta/token/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnion_ShouldReturnExpansionsOnAnySet(t *testing.T) {
+	first := NewSetBuilder().AddStrings("config", "buffer").Build()
+	second := Lazy(func() Set { return NewSetBuilder().AddStrings("Context").Build() })
+
+	got := Union(first, second)
+
+	assert.Equal(t, "buffer config context", got.String())
+	assert.Equal(t, "buffer config", first.String(), "original set shouldn't be modified")
+}
+
+func TestIntersect_ShouldReturnExpansionsOnEverySet(t *testing.T) {
+	known := NewSetBuilder().AddList(lists.KnownAbbreviations).Build()
+	project := NewSetBuilder().AddStrings("dont", "config").Build()
+
+	got := Intersect(known, project)
+
+	assert.Equal(t, "dont", got.String())
+	assert.True(t, got.Contains("DONT"))
+}
+
+func TestDifference_ShouldReturnExpansionsOnlyOnFirstSet(t *testing.T) {
+	first := NewSetBuilder().AddStrings("func", "function", "config").Build()
+	stop := NewSetBuilder().AddList(lists.Stop).Build()
+
+	got := Difference(first, stop)
+
+	assert.Equal(t, "config function", got.String())
+}
+
+func TestFilter_ShouldReturnExpansionsSatisfyingPredicate(t *testing.T) {
+	s := NewSetBuilder().AddStrings("a", "ab", "abc").Build()
+
+	got := Filter(s, func(word string) bool { return len(word) > 1 })
+
+	assert.ElementsMatch(t, []string{"ab", "abc"}, got.Array())
+	assert.Equal(t, "ab abc", got.String())
+}
@@ -3,6 +3,7 @@ package expansion
 import (
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/eroatta/token/lists"
 )
@@ -17,10 +18,19 @@ type Set interface {
 	Contains(string) bool
 }
 
+// WeightedSet represents a set of expansions where each expansion has a weight, such as its frequency on
+// a corpus or a priority tier.
+type WeightedSet interface {
+	Set
+	// Weight returns the weight for an expansion, or zero if it has no weight.
+	Weight(string) float64
+}
+
 // Set represents a set expansions stored in convenient format.
 type set struct {
 	words         lists.List
 	wordsAsString string
+	weights       map[string]float64
 }
 
 func (s set) Array() []string {
@@ -35,6 +45,10 @@ func (s set) Contains(word string) bool {
 	return s.words.Contains(word)
 }
 
+func (s set) Weight(word string) float64 {
+	return s.weights[strings.ToLower(word)]
+}
+
 // NewSetBuilder creates a new SetBuilder.
 func NewSetBuilder() SetBuilder {
 	return &setBuilder{
@@ -43,6 +57,7 @@ func NewSetBuilder() SetBuilder {
 }
 
 // SetBuilder builds an expansion set based on the added lists or strings.
+// If a lists.WeightedList is added, the weights for its words are kept on the set.
 type SetBuilder interface {
 	AddList(lists.List) SetBuilder
 	AddStrings(...string) SetBuilder
@@ -50,13 +65,26 @@ type SetBuilder interface {
 }
 
 type setBuilder struct {
-	wb lists.ListBuilder
+	wb      lists.ListBuilder
+	weights map[string]float64
 }
 
 func (sb *setBuilder) AddList(list lists.List) SetBuilder {
-	if list != nil {
-		sb.wb.Add(list.Elements()...)
+	if list == nil {
+		return sb
+	}
+
+	elements := list.Elements()
+	sb.wb.Add(elements...)
+	if weighted, ok := list.(lists.WeightedList); ok {
+		if sb.weights == nil {
+			sb.weights = make(map[string]float64, len(elements))
+		}
+		for _, e := range elements {
+			sb.weights[strings.ToLower(e)] = weighted.Weight(e)
+		}
 	}
+
 	return sb
 }
 
@@ -66,7 +94,14 @@ func (sb *setBuilder) AddStrings(strs ...string) SetBuilder {
 }
 
 func (sb *setBuilder) Build() Set {
-	list := sb.wb.Build()
+	s := newSet(sb.wb.Build())
+	s.weights = sb.weights
+
+	return s
+}
+
+// newSet creates a set with the words on the given list.
+func newSet(list lists.List) *set {
 	elements := list.Elements()
 	sort.Strings(elements)
 
@@ -75,3 +110,44 @@ func (sb *setBuilder) Build() Set {
 		wordsAsString: strings.Join(elements, " "),
 	}
 }
+
+// Lazy creates a set that defers its creation until it's used for the first time.
+// The build function is called only once, and its result is kept for any further use.
+func Lazy(build func() Set) Set {
+	return &lazySet{build: build}
+}
+
+type lazySet struct {
+	once  sync.Once
+	build func() Set
+	set   Set
+}
+
+func (l *lazySet) get() Set {
+	l.once.Do(func() {
+		l.set = l.build()
+		l.build = nil
+	})
+
+	return l.set
+}
+
+func (l *lazySet) Array() []string {
+	return l.get().Array()
+}
+
+func (l *lazySet) String() string {
+	return l.get().String()
+}
+
+func (l *lazySet) Contains(word string) bool {
+	return l.get().Contains(word)
+}
+
+func (l *lazySet) Weight(word string) float64 {
+	if weighted, ok := l.get().(WeightedSet); ok {
+		return weighted.Weight(word)
+	}
+
+	return 0.0
+}
@@ -97,3 +97,29 @@ func TestContains_OnSet_ShouldRetrieveIfContainedOrNot(t *testing.T) {
 		})
 	}
 }
+
+func TestLazy_ShouldBuildSetOnFirstUseOnlyOnce(t *testing.T) {
+	calls := 0
+	set := Lazy(func() Set {
+		calls++
+		return NewSetBuilder().AddStrings("beta", "alpha").Build()
+	})
+
+	assert.Equal(t, 0, calls)
+	assert.True(t, set.Contains("alpha"))
+	assert.Equal(t, "alpha beta", set.String())
+	assert.ElementsMatch(t, []string{"alpha", "beta"}, set.Array())
+	assert.Equal(t, 1, calls)
+}
+
+func TestWeight_OnSetWithWeightedList_ShouldRetrieveWeights(t *testing.T) {
+	weighted := lists.NewWeightedBuilder().Add("Config", 5).Build()
+	s := NewSetBuilder().AddList(weighted).AddStrings("buffer").Build()
+
+	got, ok := s.(WeightedSet)
+
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, got.Weight("config"))
+	assert.Equal(t, 0.0, got.Weight("buffer"))
+	assert.Equal(t, 0.0, got.Weight("missing"))
+}
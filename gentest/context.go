@@ -0,0 +1,133 @@
+package gentest
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/eroatta/token/conserv"
+	"github.com/eroatta/token/errs"
+	"github.com/eroatta/token/lists"
+)
+
+var nonLetters = regexp.MustCompile("[^A-Za-z]+")
+
+// ContextFromSource parses the Go source code and builds the context for the identifier found at the
+// given byte offset, as ContextFromFile does. The source can be provided as for parser.ParseFile.
+// An errs.ErrInvalidOffset error is returned if the offset is out of the source.
+func ContextFromSource(filename string, src interface{}, offset int, options ...Option) (lists.List, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	tokFile := fset.File(file.Pos())
+	if offset < 0 || offset > tokFile.Size() {
+		return nil, fmt.Errorf("%w: %d is out of the source (%d bytes)", errs.ErrInvalidOffset, offset, tokFile.Size())
+	}
+
+	return ContextFromFile(file, tokFile.Pos(offset), options...), nil
+}
+
+// ContextFromFile builds the context for the identifier found at the given position of a file parsed
+// with comments. The context is built from the innermost function holding the position, or from the
+// declaration holding it when it's found outside any function, as NewContext does.
+// The comments attached to the function or declaration are also part of the context.
+func ContextFromFile(file *ast.File, pos token.Pos, options ...Option) lists.List {
+	var enclosing ast.Node = file
+	var doc *ast.CommentGroup
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil || pos < node.Pos() || pos >= node.End() {
+			return false
+		}
+
+		switch n := node.(type) {
+		case *ast.FuncDecl:
+			enclosing, doc = n, n.Doc
+		case *ast.FuncLit:
+			enclosing = n
+		case *ast.GenDecl:
+			enclosing, doc = n, n.Doc
+		}
+		return true
+	})
+
+	comments := []*ast.CommentGroup{doc}
+	if enclosing == ast.Node(file) {
+		comments = file.Comments
+	} else {
+		for _, group := range file.Comments {
+			if group.Pos() >= enclosing.Pos() && group.End() <= enclosing.End() {
+				comments = append(comments, group)
+			}
+		}
+	}
+
+	return NewContext(enclosing, comments, options...)
+}
+
+// ContextFromNode builds the context words for GenTest from a node, such as a function declaration,
+// and the given comments, as proposed by Lawrie, Binkley and Morrell. The context holds:
+// * the words from the identifiers found on the node, split with Conserv,
+// * the words from the string literals found on the node,
+// * the words from the comments.
+//
+// Words found on lists.Stop and single letter words are discarded.
+func ContextFromNode(node ast.Node, comments ...*ast.CommentGroup) lists.List {
+	return NewContext(node, comments)
+}
+
+// NewContext works as ContextFromNode, but accepts options, such as WithExcludedWords to discard
+// common English words found on the comments.
+func NewContext(node ast.Node, comments []*ast.CommentGroup, options ...Option) lists.List {
+	conf := newConfig(options)
+
+	builder := lists.NewBuilder()
+	add := func(words ...string) {
+		for _, word := range words {
+			if len(word) > 1 && !lists.Stop.Contains(word) && !conf.isExcluded(word) {
+				builder.Add(word)
+			}
+		}
+	}
+
+	if node != nil {
+		ast.Inspect(node, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.Ident:
+				add(strings.Fields(conserv.Split(v.Name))...)
+			case *ast.BasicLit:
+				if v.Kind == token.STRING {
+					if literal, err := strconv.Unquote(v.Value); err == nil {
+						add(textWords(literal)...)
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	for _, group := range comments {
+		if group != nil {
+			add(textWords(group.Text())...)
+		}
+	}
+
+	return builder.Build()
+}
+
+// textWords splits a text, such as a comment or a string literal, on its lower case words.
+// Words written on camel case are split with Conserv.
+func textWords(text string) []string {
+	words := make([]string, 0)
+	for _, field := range strings.Fields(nonLetters.ReplaceAllString(text, " ")) {
+		words = append(words, strings.Fields(conserv.Split(field))...)
+	}
+
+	return words
+}
@@ -0,0 +1,154 @@
+package gentest
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/eroatta/token/errs"
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+const contextSource = `package sample
+
+// Package level comment about networking.
+
+// maxRetries limits the connection attempts.
+const maxRetries = 3
+
+// sendRequest writes the HTTP request to the remote server.
+func sendRequest(conn *Connection, req *Request) error {
+	// serialize the payload first
+	payload := encodePayload(req)
+	if _, err := conn.Write(payload); err != nil {
+		return fmt.Errorf("unable to write payload: %w", err)
+	}
+	return nil
+}
+
+// readResponse reads the response.
+func readResponse(conn *Connection) *Response {
+	return nil
+}
+`
+
+func TestContextFromSource_OnPositionInsideFunction_ShouldReturnFunctionWords(t *testing.T) {
+	offset := strings.Index(contextSource, "payload := ")
+
+	context, err := ContextFromSource("sample.go", contextSource, offset)
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		// identifiers
+		"send", "request", "conn", "connection", "req", "error", "payload", "encode", "err", "write", "errorf", "nil",
+		// string literals
+		"unable", "to",
+		// comments
+		"writes", "the", "remote", "server", "serialize", "first",
+	}, context.Elements())
+}
+
+func TestContextFromSource_OnPositionInsideDeclaration_ShouldReturnDeclarationWords(t *testing.T) {
+	offset := strings.Index(contextSource, "maxRetries =")
+
+	context, err := ContextFromSource("sample.go", contextSource, offset)
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"max", "retries", "limits", "the", "connection", "attempts"}, context.Elements())
+}
+
+func TestContextFromSource_OnExcludedWords_ShouldDiscardEnglishStopWords(t *testing.T) {
+	offset := strings.Index(contextSource, "payload := ")
+
+	context, err := ContextFromSource("sample.go", contextSource, offset, WithExcludedWords(lists.EnglishStop))
+
+	assert.NoError(t, err)
+	assert.False(t, context.Contains("the"))
+	assert.False(t, context.Contains("to"))
+	assert.True(t, context.Contains("remote"))
+	assert.True(t, context.Contains("payload"))
+}
+
+func TestContextFromSource_OnPositionOutsideDeclarations_ShouldReturnFileWords(t *testing.T) {
+	context, err := ContextFromSource("sample.go", contextSource, 0)
+
+	assert.NoError(t, err)
+	assert.True(t, context.Contains("sample"))
+	assert.True(t, context.Contains("networking"))
+	assert.True(t, context.Contains("response"))
+	assert.True(t, context.Contains("payload"))
+}
+
+func TestContextFromSource_OnInvalidSource_ShouldReturnError(t *testing.T) {
+	context, err := ContextFromSource("invalid.go", "package", 0)
+
+	assert.Error(t, err)
+	assert.Nil(t, context)
+}
+
+func TestContextFromSource_OnOffsetOutOfSource_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int
+	}{
+		{"negative_offset", -1},
+		{"offset_after_end", len(contextSource) + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			context, err := ContextFromSource("sample.go", contextSource, tt.offset)
+
+			assert.True(t, errors.Is(err, errs.ErrInvalidOffset))
+			assert.Nil(t, context)
+		})
+	}
+}
+
+func TestContextFromNode_OnFunctionLiteral_ShouldDiscardStopAndSingleLetterWords(t *testing.T) {
+	fset := token.NewFileSet()
+	expr, err := parser.ParseExprFrom(fset, "", `func(s string, n int) bool { return n > 0 && s != "x" }`, 0)
+	assert.NoError(t, err)
+
+	context := ContextFromNode(expr)
+
+	assert.Equal(t, 0, context.Size())
+}
+
+func TestContextFromNode_OnNodeAndComments_ShouldReturnWords(t *testing.T) {
+	node := &ast.Ident{Name: "clientResponse"}
+	comments := &ast.CommentGroup{List: []*ast.Comment{{Text: "// builds the JavaScript Object Notation"}}}
+
+	context := ContextFromNode(node, comments, nil)
+
+	assert.ElementsMatch(t, []string{"client", "response", "builds", "the", "java", "script", "object", "notation"},
+		context.Elements())
+}
+
+func TestNewContext_OnExcludedWords_ShouldDiscardThem(t *testing.T) {
+	node := &ast.Ident{Name: "clientResponse"}
+	comments := []*ast.CommentGroup{{List: []*ast.Comment{{Text: "// builds the JavaScript Object Notation"}}}}
+
+	context := NewContext(node, comments, WithExcludedWords(lists.NewBuilder().Add("the", "builds").Build()))
+
+	assert.ElementsMatch(t, []string{"client", "response", "java", "script", "object", "notation"},
+		context.Elements())
+}
+
+func TestSplit_OnContextFromSource_ShouldUseSurroundingWords(t *testing.T) {
+	offset := strings.Index(contextSource, "payload := ")
+	context, err := ContextFromSource("sample.go", contextSource, offset)
+	assert.NoError(t, err)
+
+	simCalc := similarityCalculatorMock{"connection-request": 0.9}
+	peSet := expansion.NewSetBuilder().AddStrings("connection", "request").Build()
+
+	got := Expand("connreq", simCalc, context, peSet)
+
+	assert.Equal(t, []string{"connection", "request"}, got)
+}
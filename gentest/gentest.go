@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/expansion"
 	"github.com/eroatta/token/lists"
 	"github.com/eroatta/token/marker"
@@ -37,8 +38,10 @@ type SimilarityCalculator interface {
 //
 // The potential split with the highest score is the selected split, and all the combined selected splits
 // form the splitted token.
-func Split(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set) []string {
-	parts := generateAndTest(token, simCalc, context, peSet)
+//
+// The behaviour can be customized using options, such as WithDigitPolicy.
+func Split(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set, options ...Option) []string {
+	parts := generateAndTest(token, simCalc, context, peSet, newConfig(options))
 
 	splits := make([]string, 0, len(parts))
 	for _, part := range parts {
@@ -64,8 +67,10 @@ func Split(token string, simCalc SimilarityCalculator, context lists.List, peSet
 //
 // The potential split with the highest score is the selected split, and all the combined best expansions
 // form the expanded token.
-func Expand(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set) []string {
-	parts := generateAndTest(token, simCalc, context, peSet)
+//
+// The behaviour can be customized using options, such as WithDigitPolicy.
+func Expand(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set, options ...Option) []string {
+	parts := generateAndTest(token, simCalc, context, peSet, newConfig(options))
 
 	expansions := make([]string, 0, len(parts))
 	for _, part := range parts {
@@ -75,13 +80,59 @@ func Expand(token string, simCalc SimilarityCalculator, context lists.List, peSe
 	return expansions
 }
 
-func generateAndTest(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set) []potentialSplit {
+// TrySplit on GenTest works as Split, but returns an error when the token can't be split because of
+// the input: errs.ErrEmptyToken for an empty token, errs.ErrNilCalculator when the similarity calculator
+// is nil, errs.ErrEmptyContext when the context is nil or empty, or errs.ErrNilList when the set of
+// possible expansions is nil.
+func TrySplit(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set, options ...Option) ([]string, error) {
+	if err := validate(token, simCalc, context, peSet); err != nil {
+		return nil, err
+	}
+
+	return Split(token, simCalc, context, peSet, options...), nil
+}
+
+// TryExpand on GenTest works as Expand, but returns an error as TrySplit does.
+func TryExpand(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set, options ...Option) ([]string, error) {
+	if err := validate(token, simCalc, context, peSet); err != nil {
+		return nil, err
+	}
+
+	return Expand(token, simCalc, context, peSet, options...), nil
+}
+
+func validate(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set) error {
+	if strings.TrimSpace(token) == "" {
+		return errs.ErrEmptyToken
+	}
+
+	if simCalc == nil {
+		return errs.ErrNilCalculator
+	}
+
+	if context == nil || context.Size() == 0 {
+		return errs.ErrEmptyContext
+	}
+
+	if peSet == nil {
+		return errs.ErrNilList
+	}
+
+	return nil
+}
+
+func generateAndTest(token string, simCalc SimilarityCalculator, context lists.List, peSet expansion.Set, conf config) []potentialSplit {
 	similarity := func(w1 string, w2 string) float64 {
 		return similarityScore(simCalc, w1, w2)
 	}
-	contextWords := context.Elements()
+	contextWords := make([]string, 0, context.Size())
+	for _, word := range context.Elements() {
+		if !conf.isExcluded(word) {
+			contextWords = append(contextWords, word)
+		}
+	}
 
-	preprocessedToken := marker.OnDigits(token)
+	preprocessedToken := conf.digits(token)
 	preprocessedToken = marker.OnLowerToUpperCase(preprocessedToken)
 
 	selectedSplits := make([]potentialSplit, 0, 10)
@@ -1,11 +1,14 @@
 package gentest
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/expansion"
 	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/marker"
 
 	"math"
 
@@ -51,6 +54,29 @@ func TestSplit_ShouldReturnValidSplits(t *testing.T) {
 	}
 }
 
+func TestSplit_OnDigitPolicies_ShouldHandleDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy marker.DigitPolicy
+		want   []string
+	}{
+		{"split_by_default", nil, []string{"md", "5", "Hash"}},
+		{"split_digits", marker.SplitDigits, []string{"md", "5", "Hash"}},
+		{"glue_digits", marker.GlueDigits(marker.DigitWords), []string{"md5", "Hash"}},
+	}
+
+	expansionsSet := expansion.NewSetBuilder().AddStrings("md5", "hash").Build()
+	context := lists.NewBuilder().Build()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split("md5Hash", similarityCalculatorMock{}, context, expansionsSet, WithDigitPolicy(tt.policy))
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestGeneratePotentialSplits_ShouldReturnEveryPossibleCombination(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -248,3 +274,47 @@ func BenchmarkGenerate(b *testing.B) {
 		})
 	}
 }
+
+func TestTrySplitAndTryExpand_OnGenTest_ShouldReturnResultsOrError(t *testing.T) {
+	simCalc := similarityCalculatorMock{"no-type": 0.8564}
+	context := lists.NewBuilder().Add("no", "type").Build()
+	expansionsSet := expansion.NewSetBuilder().AddStrings("no", "type").Build()
+
+	tests := []struct {
+		name     string
+		token    string
+		simCalc  SimilarityCalculator
+		context  lists.List
+		peSet    expansion.Set
+		expected []string
+		err      error
+	}{
+		{"valid_token", "notype", simCalc, context, expansionsSet, []string{"no", "type"}, nil},
+		{"empty_token", "", simCalc, context, expansionsSet, nil, errs.ErrEmptyToken},
+		{"nil_calculator", "notype", nil, context, expansionsSet, nil, errs.ErrNilCalculator},
+		{"nil_context", "notype", simCalc, nil, expansionsSet, nil, errs.ErrEmptyContext},
+		{"empty_context", "notype", simCalc, lists.NewBuilder().Build(), expansionsSet, nil, errs.ErrEmptyContext},
+		{"nil_expansions", "notype", simCalc, context, nil, nil, errs.ErrNilList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			splits, err := TrySplit(tt.token, tt.simCalc, tt.context, tt.peSet)
+			assert.Equal(t, tt.expected, splits)
+			assert.True(t, errors.Is(err, tt.err))
+
+			expansions, err := TryExpand(tt.token, tt.simCalc, tt.context, tt.peSet)
+			assert.Equal(t, tt.expected, expansions)
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+}
+
+func TestExpand_OnExcludedWords_ShouldIgnoreThemOnContextScoring(t *testing.T) {
+	simCalc := similarityCalculatorMock{"strange-the": 0.9, "string-text": 0.5}
+	context := lists.NewBuilder().Add("the", "text").Build()
+	peSet := expansion.NewSetBuilder().AddStrings("string", "strange").Build()
+
+	assert.Equal(t, []string{"strange"}, Expand("str", simCalc, context, peSet))
+	assert.Equal(t, []string{"string"}, Expand("str", simCalc, context, peSet, WithExcludedWords(lists.EnglishStop)))
+}
@@ -0,0 +1,49 @@
+package gentest
+
+import (
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/marker"
+)
+
+// Option configures the behaviour of GenTest.
+type Option func(*config)
+
+type config struct {
+	digits   marker.DigitPolicy
+	excluded lists.List
+}
+
+func newConfig(options []Option) config {
+	conf := config{
+		digits: marker.SplitDigits,
+	}
+	for _, option := range options {
+		option(&conf)
+	}
+
+	return conf
+}
+
+// WithDigitPolicy sets the policy used to split letters and digits.
+// By default, the marker.SplitDigits policy is used.
+func WithDigitPolicy(policy marker.DigitPolicy) Option {
+	return func(c *config) {
+		if policy != nil {
+			c.digits = policy
+		}
+	}
+}
+
+// WithExcludedWords excludes the words found on the list, such as lists.EnglishStop, from the context.
+// It applies both when building the context, using ContextFromFile, ContextFromSource or NewContext,
+// and when scoring the expansions against the context on Split and Expand.
+func WithExcludedWords(excluded lists.List) Option {
+	return func(c *config) {
+		c.excluded = excluded
+	}
+}
+
+// isExcluded checks if the word must be left out of the context.
+func (c config) isExcluded(word string) bool {
+	return c.excluded != nil && c.excluded.Contains(word)
+}
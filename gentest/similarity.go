@@ -0,0 +1,78 @@
+package gentest
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// SimilarityTable is a SimilarityCalculator backed by precomputed scores for pairs of words.
+// Pairs not found on the table have zero similarity.
+type SimilarityTable struct {
+	scores map[string]float64
+}
+
+// NewSimilarityTable creates and initializes an empty similarity table.
+func NewSimilarityTable() *SimilarityTable {
+	return &SimilarityTable{
+		scores: make(map[string]float64),
+	}
+}
+
+// NewSimilarityTableFromReader builds a similarity table from a reader, where each line holds two words
+// and their similarity score, separated by spaces. Empty lines or lines starting with # are ignored.
+func NewSimilarityTableFromReader(r io.Reader) (*SimilarityTable, error) {
+	table := NewSimilarityTable()
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("line %d: expected two words and a score, found %q", n, line)
+		}
+
+		score, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		table.Set(fields[0], fields[1], score)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+// Set sets the similarity score for a pair of words, regardless of their order.
+func (s *SimilarityTable) Set(word string, another string, score float64) {
+	s.scores[pairKey(word, another)] = score
+}
+
+// Similarity returns the similarity score for a pair of words, regardless of their order.
+func (s *SimilarityTable) Similarity(word string, another string) float64 {
+	return s.scores[pairKey(word, another)]
+}
+
+// Size returns the number of pairs on the table.
+func (s *SimilarityTable) Size() int {
+	return len(s.scores)
+}
+
+func pairKey(word string, another string) string {
+	word, another = strings.ToLower(word), strings.ToLower(another)
+	if word > another {
+		word, another = another, word
+	}
+
+	return word + "-" + another
+}
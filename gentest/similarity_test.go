@@ -0,0 +1,47 @@
+package gentest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimilarity_OnSimilarityTable_ShouldIgnoreWordsOrder(t *testing.T) {
+	table := NewSimilarityTable()
+	table.Set("HTTP", "response", 0.9)
+
+	assert.Equal(t, 0.9, table.Similarity("http", "response"))
+	assert.Equal(t, 0.9, table.Similarity("response", "http"))
+	assert.Equal(t, 0.0, table.Similarity("http", "request"))
+	assert.Equal(t, 1, table.Size())
+}
+
+func TestNewSimilarityTableFromReader_OnValidInput_ShouldReturnSimilarityTable(t *testing.T) {
+	input := "# word word score\nhttp response 0.9\n\nno type 0.8564\n"
+
+	table, err := NewSimilarityTableFromReader(strings.NewReader(input))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, table.Size())
+	assert.Equal(t, 0.8564, table.Similarity("type", "no"))
+}
+
+func TestNewSimilarityTableFromReader_OnInvalidInput_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"missing_score", "http response\n"},
+		{"invalid_score", "http response high\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table, err := NewSimilarityTableFromReader(strings.NewReader(tt.input))
+
+			assert.Error(t, err)
+			assert.Nil(t, table)
+		})
+	}
+}
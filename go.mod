@@ -1,10 +1,24 @@
 module github.com/eroatta/token
 
-go 1.12
+go 1.23.0
+
+require (
+	github.com/reiver/go-porterstemmer v1.0.1
+	github.com/stretchr/testify v1.7.0
+	golang.org/x/tools v0.34.0
+	google.golang.org/grpc v1.45.0
+	google.golang.org/protobuf v1.28.0
+)
 
 require (
 	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/reiver/go-porterstemmer v1.0.1
-	github.com/stretchr/testify v1.2.1
+	golang.org/x/mod v0.25.0 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sync v0.15.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.26.0 // indirect
+	google.golang.org/genproto v0.0.0-20200526211855-cb27e3aa2013 // indirect
+	gopkg.in/yaml.v3 v3.0.0-20200313102051-9f266ea9e77c // indirect
 )
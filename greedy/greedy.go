@@ -4,24 +4,41 @@ package greedy
 
 import (
 	"strings"
+	"unicode/utf8"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/lists"
 	"github.com/eroatta/token/marker"
+	"github.com/eroatta/token/split"
 )
 
 // Separator specifies the current separator.
 var Separator string = " "
 
+// provenance identifies the soft words produced by Greedy.
+const provenance = "greedy"
+
 // DefaultList contains the words included on the default configuration for Greedy,
 // defined on Field, Binkley and Lawrie's paper.
 // This list includes words from:
 // * a dictionary
 // * a known abbreviations list
 // * a stop list
-var DefaultList = lists.NewBuilder().Add(lists.Dictionary.Elements()...).
-	Add(lists.KnownAbbreviations.Elements()...).
-	Add(lists.Stop.Elements()...).
-	Build()
+//
+// The list is built the first time it's used.
+var DefaultList = lists.Lazy(func() lists.List { return NewList(lists.Dictionary, lists.Stop) })
+
+// NewList creates a list of words for Greedy, using the given dictionary and stop list along with
+// the known abbreviations list. It allows building the list for a chosen language, or a mix of them:
+//
+//	dictionary, err := lists.DictionaryFor("en", "es")
+//	list := greedy.NewList(dictionary, lists.Stop)
+//
+// If the dictionary or the stop list is a lists.WeightedList, the list keeps the weights, so they're
+// used to break ties; words found only on unweighted lists weigh zero.
+func NewList(dictionary lists.List, stop lists.List) lists.List {
+	return lists.Union(dictionary, lists.KnownAbbreviations, stop)
+}
 
 // Split on Greedy receives a token and returns an array of hard and soft words,
 // split by the Greedy algorithm proposed by Field, Binkley and Lawrie.
@@ -29,8 +46,22 @@ var DefaultList = lists.NewBuilder().Add(lists.Dictionary.Elements()...).
 // that cannot be matched to any word on the list.
 // The process evaluates prefixes and suffixes recursively until any of them are found on the list,
 // preferring longer words.
-func Split(token string, list lists.List) string {
-	preprocessedToken := marker.OnDigits(token)
+// When the list is a lists.WeightedList, the weights are used to break ties between the prefix and
+// suffix splittings.
+//
+// The behaviour can be customized using options, such as WithExhaustiveSearch, WithUnknownPolicy or
+// WithDigitPolicy.
+func Split(token string, list lists.List, options ...Option) string {
+	return SplitParts(token, list, options...).Join(Separator)
+}
+
+// SplitParts on Greedy works as Split, but returns a structured result where the segments not found
+// on the list are marked as unknown. By default, each unknown segment is kept as a single part, but a
+// different UnknownPolicy can be set using the WithUnknownPolicy option.
+func SplitParts(token string, list lists.List, options ...Option) split.Result {
+	conf := newConfig(options)
+
+	preprocessedToken := conf.digits(token)
 	preprocessedToken = marker.OnLowerToUpperCase(preprocessedToken)
 	preprocessedToken = strings.ToLower(preprocessedToken)
 
@@ -42,12 +73,74 @@ func Split(token string, list lists.List) string {
 			preffixSplittings := marker.SplitBy(findPrefix(s, "", list))
 			suffixSplittings := marker.SplitBy(findSuffix(s, "", list))
 			chosenSplittings := chooseSplittings(preffixSplittings, suffixSplittings, list)
+			if conf.exhaustive {
+				chosenSplittings = bestSegmentation(s, list)
+			}
 
 			splitToken = append(splitToken, chosenSplittings...)
 		}
 	}
 
-	return strings.Join(splitToken, Separator)
+	result := make(split.Result, 0, len(splitToken))
+	for _, part := range split.FromWords(token, splitToken, provenance) {
+		if list.Contains(part.Word) {
+			result = append(result, part)
+			continue
+		}
+
+		// recover the segment with its original case, which can take a different number of bytes
+		segment := part.Word
+		if original := originalSegment(token, part); strings.EqualFold(original, part.Word) {
+			segment = original
+		}
+
+		for _, unknown := range conf.unknown(segment) {
+			unknown.Offset += part.Offset
+			unknown.Unknown = true
+			result = append(result, unknown)
+		}
+	}
+
+	return result
+}
+
+// TrySplit on Greedy works as Split, but returns an error when the token can't be split because of
+// the input: errs.ErrEmptyToken for an empty token, or errs.ErrNilList when the list is nil.
+func TrySplit(token string, list lists.List, options ...Option) (string, error) {
+	result, err := TrySplitParts(token, list, options...)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Join(Separator), nil
+}
+
+// TrySplitParts on Greedy works as SplitParts, but returns an error as TrySplit does.
+func TrySplitParts(token string, list lists.List, options ...Option) (split.Result, error) {
+	if strings.TrimSpace(token) == "" {
+		return nil, errs.ErrEmptyToken
+	}
+
+	if list == nil {
+		return nil, errs.ErrNilList
+	}
+
+	return SplitParts(token, list, options...), nil
+}
+
+// originalSegment retrieves the segment of the token found at the offset of the part, holding as many
+// characters as the part's word.
+func originalSegment(token string, part split.Part) string {
+	end := part.Offset
+	for range part.Word {
+		if end >= len(token) {
+			break
+		}
+		_, size := utf8.DecodeRuneInString(token[end:])
+		end += size
+	}
+
+	return token[part.Offset:end]
 }
 
 // findPrefix looks for the longest prefix exinsting on the list.
@@ -90,14 +183,38 @@ func findSuffix(token string, splitToken string, list lists.List) string {
 
 // chooseSplittings calculates the ratio between found words on the list and
 // the total number of splittings and chooses the proper splitting.
+// If both splittings have the same ratio and the list is a weighted list, the splitting with
+// the highest average weight is chosen.
 func chooseSplittings(preffixSplittings []string, suffixSplittings []string, list lists.List) []string {
-	if inListRatio(preffixSplittings, list) > inListRatio(suffixSplittings, list) {
+	preffixRatio := inListRatio(preffixSplittings, list)
+	suffixRatio := inListRatio(suffixSplittings, list)
+	if preffixRatio > suffixRatio {
+		return preffixSplittings
+	}
+
+	if weighted, ok := list.(lists.WeightedList); ok && preffixRatio == suffixRatio &&
+		averageWeight(preffixSplittings, weighted) > averageWeight(suffixSplittings, weighted) {
 		return preffixSplittings
 	}
 
 	return suffixSplittings
 }
 
+// averageWeight calculates the average weight of the given words on the weighted list.
+// Words not found on the list weight zero.
+func averageWeight(words []string, list lists.WeightedList) float64 {
+	if len(words) == 0 {
+		return 0.0
+	}
+
+	var total float64
+	for _, word := range words {
+		total += list.Weight(word)
+	}
+
+	return total / float64(len(words))
+}
+
 // inListRatio calculates the ratio between the total words
 // passed as parameter vs. the total of those words found the list.
 func inListRatio(words []string, list lists.List) float64 {
@@ -1,9 +1,15 @@
 package greedy
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/marker"
+	"github.com/eroatta/token/samurai"
+	"github.com/eroatta/token/split"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,3 +45,237 @@ func BenchmarkGreedySplitting(b *testing.B) {
 		Split("GPSstate", list)
 	}
 }
+
+func TestNewList_ShouldIncludeDictionaryAbbreviationsAndStopList(t *testing.T) {
+	dictionary := lists.NewBuilder().Add("casa", "perro").Build()
+	stop := lists.NewBuilder().Add("func").Build()
+
+	got := NewList(dictionary, stop)
+
+	assert.True(t, got.Contains("casa"))
+	assert.True(t, got.Contains("func"))
+	assert.True(t, got.Contains("dont"))
+	assert.False(t, got.Contains("house"))
+}
+
+func TestSplit_OnListForJava_ShouldSplitUsingJavaStopList(t *testing.T) {
+	java, _ := lists.StopFor("java")
+	list := NewList(lists.NewBuilder().Add("get").Build(), java)
+
+	got := Split("getarraylist", list)
+
+	assert.Equal(t, "get arraylist", got)
+}
+
+func TestSplit_OnWeightedList_ShouldBreakTiesUsingWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights map[string]float64
+		want    string
+	}{
+		{"suffix_splitting_by_default", map[string]float64{"now": 1, "here": 1, "no": 1, "where": 1}, "no where"},
+		{"preffix_splitting_with_higher_weight", map[string]float64{"now": 10, "here": 10, "no": 1, "where": 1}, "now here"},
+		{"suffix_splitting_with_higher_weight", map[string]float64{"now": 1, "here": 1, "no": 10, "where": 10}, "no where"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := lists.NewWeightedBuilder()
+			for word, weight := range tt.weights {
+				builder.Add(word, weight)
+			}
+
+			got := Split("nowhere", builder.Build())
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplit_OnNewListWithWeightedDictionary_ShouldBreakTiesUsingWeights(t *testing.T) {
+	dictionary := lists.NewWeightedBuilder().Add("now", 10).Add("here", 10).Add("no", 1).Add("where", 1).Build()
+
+	list := NewList(dictionary, lists.Stop)
+
+	assert.Equal(t, "now here", Split("nowhere", list))
+	assert.Equal(t, "now here", Split("nowhere", lists.Lazy(func() lists.List { return list })))
+}
+
+func TestSplit_OnExhaustiveSearch_ShouldFindWordsInTheMiddle(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		options []Option
+		want    string
+	}{
+		{"two_paths_by_default", "getxvalue", nil, "getx value"},
+		{"exhaustive_finds_known_word_in_the_middle", "getxvalue", []Option{WithExhaustiveSearch(0)}, "get x value"},
+		{"exhaustive_prefers_fewest_parts", "getvalue", []Option{WithExhaustiveSearch(0)}, "get value"},
+		{"exhaustive_prefers_longest_word", "notype", []Option{WithExhaustiveSearch(0)}, "no type"},
+		{"exhaustive_unknown_word", "zzz", []Option{WithExhaustiveSearch(0)}, "zzz"},
+		{"exhaustive_in_list_word", "value", []Option{WithExhaustiveSearch(0)}, "value"},
+		{"exhaustive_prefers_highest_ratio_over_fewest_parts", "namexgetvalue", []Option{WithExhaustiveSearch(0)}, "name x get value"},
+		{"exhaustive_with_markers_and_limit", "getNamexvalue", []Option{WithExhaustiveSearch(10)}, "get name x value"},
+	}
+
+	list := lists.NewBuilder().Add("get", "value", "getv", "alue", "no", "not", "type", "ype", "name").Build()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.token, list, tt.options...)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplit_OnExhaustiveSearchWithWeightedList_ShouldPreferHeavierWords(t *testing.T) {
+	dictionary := lists.NewWeightedBuilder().
+		Add("this", 500).Add("is", 900).Add("a", 1000).Add("very", 300).Add("long", 200).Add("identifier", 10).
+		Add("without", 100).Add("case", 80).Add("th", 1).Add("isis", 1).Add("avery", 1).Add("sis", 1).Add("av", 1).
+		Build()
+	list := NewList(dictionary, lists.Stop)
+
+	got := Split("thisisaverylongidentifierwithoutcase", list, WithExhaustiveSearch(0))
+
+	assert.Equal(t, "this is a very long identifier without case", got)
+}
+
+func TestSplit_OnExhaustiveSearchWithDefaultList_ShouldPreferEnglishStopWords(t *testing.T) {
+	got := Split("thisisavalue", DefaultList, WithExhaustiveSearch(0))
+
+	assert.Equal(t, "this is a value", got)
+}
+
+func TestBestSegmentation_OnLongHardWord_ShouldNotEnumerateSegmentations(t *testing.T) {
+	list := lists.NewBuilder().Add("a", "aa", "aaa").Build()
+
+	got := bestSegmentation(strings.Repeat("a", 200), list)
+
+	assert.Len(t, got, 67)
+}
+
+func TestSplitParts_OnUnknownPolicies_ShouldMarkUnknownSegments(t *testing.T) {
+	localFreqTable := samurai.NewFrequencyTable()
+	localFreqTable.SetOccurrences("xml", 100)
+	localFreqTable.SetOccurrences("rpc", 100)
+	globalFreqTable := samurai.NewFrequencyTable()
+	globalFreqTable.SetOccurrences("xml", 100)
+	globalFreqTable.SetOccurrences("rpc", 100)
+	tCtx := samurai.NewTokenContext(localFreqTable, globalFreqTable)
+	noWords := lists.NewBuilder().Build()
+
+	tests := []struct {
+		name   string
+		token  string
+		policy UnknownPolicy
+		want   split.Result
+	}{
+		{"keep_by_default", "getXMLrpc", nil, split.Result{
+			{Word: "get", Offset: 0, Provenance: "greedy"},
+			{Word: "xmlrpc", Offset: 3, Unknown: true, Provenance: "greedy"},
+		}},
+		{"keep", "getXMLrpc", KeepUnknown, split.Result{
+			{Word: "get", Offset: 0, Provenance: "greedy"},
+			{Word: "xmlrpc", Offset: 3, Unknown: true, Provenance: "greedy"},
+		}},
+		{"conserv_fallback", "getXMLrpc", ConservUnknown, split.Result{
+			{Word: "get", Offset: 0, Provenance: "greedy"},
+			{Word: "xm", Offset: 3, Unknown: true, Provenance: "conserv"},
+			{Word: "lrpc", Offset: 5, Unknown: true, Provenance: "conserv"},
+		}},
+		{"samurai_fallback", "getXMLrpc", SamuraiUnknown(tCtx, noWords, noWords), split.Result{
+			{Word: "get", Offset: 0, Provenance: "greedy"},
+			{Word: "xml", Offset: 3, Unknown: true, Provenance: "samurai"},
+			{Word: "rpc", Offset: 6, Unknown: true, Provenance: "samurai"},
+		}},
+		{"drop", "getXMLrpc", DropUnknown, split.Result{
+			{Word: "get", Offset: 0, Provenance: "greedy"},
+		}},
+		{"unknown_segment_in_the_middle", "getZZvalue", DropUnknown, split.Result{
+			{Word: "get", Offset: 0, Provenance: "greedy"},
+			{Word: "value", Offset: 5, Provenance: "greedy"},
+		}},
+	}
+
+	list := lists.NewBuilder().Add("get", "value").Build()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitParts(tt.token, list, WithUnknownPolicy(tt.policy))
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitParts_OnUnknownSegmentWithNonASCIILetters_ShouldKeepTheWholeSegment(t *testing.T) {
+	list := lists.NewBuilder().Add("value").Build()
+
+	got := SplitParts("xȺyValue", list, WithExhaustiveSearch(0))
+
+	assert.Equal(t, split.Result{
+		{Word: "xⱥy", Offset: 0, Unknown: true, Provenance: "greedy"},
+		{Word: "value", Offset: 4, Provenance: "greedy"},
+	}, got)
+}
+
+func TestSplit_OnDropUnknownPolicy_ShouldOnlyIncludeKnownWords(t *testing.T) {
+	list := lists.NewBuilder().Add("get", "value").Build()
+
+	got := Split("getZZvalue", list, WithUnknownPolicy(DropUnknown))
+
+	assert.Equal(t, "get value", got)
+}
+
+func TestSplit_OnDigitPolicies_ShouldHandleDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy marker.DigitPolicy
+		want   string
+	}{
+		{"split_by_default", nil, "to int 64"},
+		{"split_digits", marker.SplitDigits, "to int 64"},
+		{"glue_digits", marker.GlueDigits(marker.DigitWords), "to int64"},
+	}
+
+	list := lists.NewBuilder().Add("to", "int").Add(lists.Stop.Elements()...).Build()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split("toInt64", list, WithDigitPolicy(tt.policy))
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTrySplit_OnGreedy_ShouldReturnSplitsOrError(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		list     lists.List
+		expected string
+		err      error
+	}{
+		{"valid_token", "getstring", lists.NewBuilder().Add("get", "string").Build(), "get string", nil},
+		{"empty_token", "", lists.NewBuilder().Build(), "", errs.ErrEmptyToken},
+		{"nil_list", "getstring", nil, "", errs.ErrNilList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TrySplit(tt.token, tt.list)
+
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+}
+
+func TestTrySplitParts_OnGreedyWithEmptyToken_ShouldReturnError(t *testing.T) {
+	got, err := TrySplitParts(" ", lists.NewBuilder().Build())
+
+	assert.Nil(t, got)
+	assert.True(t, errors.Is(err, errs.ErrEmptyToken))
+}
@@ -0,0 +1,64 @@
+package greedy
+
+import "github.com/eroatta/token/marker"
+
+// DefaultSegmentationsLimit was the default maximum number of segmentations explored for each hard word
+// when the exhaustive search is enabled.
+//
+// Deprecated: the exhaustive search finds the best segmentation without enumerating them, so there's
+// no limit anymore.
+const DefaultSegmentationsLimit = 1000
+
+// Option configures the behaviour of Greedy.
+type Option func(*config)
+
+type config struct {
+	exhaustive bool
+	unknown    UnknownPolicy
+	digits     marker.DigitPolicy
+}
+
+func newConfig(options []Option) config {
+	conf := config{
+		unknown: KeepUnknown,
+		digits:  marker.SplitDigits,
+	}
+	for _, option := range options {
+		option(&conf)
+	}
+
+	return conf
+}
+
+// WithExhaustiveSearch enables the search of the best segmentation covered by the list for each hard word,
+// instead of just comparing the prefix and the suffix splittings. Segmentations covering more characters
+// with words found on the list are preferred, then the ones holding fewer or more weighted words (words
+// found on lists.EnglishStop weigh at least one) and then the ones holding longer words.
+// The limit is no longer used, as segmentations aren't enumerated; it's kept for compatibility.
+//
+// This mode isn't part of the algorithm proposed by Feild, Binkley and Lawrie, so it's disabled by default.
+func WithExhaustiveSearch(limit int) Option {
+	return func(c *config) {
+		c.exhaustive = true
+	}
+}
+
+// WithUnknownPolicy sets the policy used to handle the segments not found on the list.
+// By default, the KeepUnknown policy is used.
+func WithUnknownPolicy(policy UnknownPolicy) Option {
+	return func(c *config) {
+		if policy != nil {
+			c.unknown = policy
+		}
+	}
+}
+
+// WithDigitPolicy sets the policy used to split letters and digits.
+// By default, the marker.SplitDigits policy is used.
+func WithDigitPolicy(policy marker.DigitPolicy) Option {
+	return func(c *config) {
+		if policy != nil {
+			c.digits = policy
+		}
+	}
+}
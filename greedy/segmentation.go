@@ -0,0 +1,116 @@
+package greedy
+
+import (
+	"github.com/eroatta/token/lists"
+)
+
+// segmentation is the best segmentation found for a prefix of a hard word, where each part is either
+// a word found on the list or a chunk of characters between found words.
+type segmentation struct {
+	parts []string
+	// uncovered is the number of characters not covered by words found on the list.
+	uncovered int
+	// cost adds the cost of each part, which is lower for the words weighing more.
+	cost float64
+	// squares adds the squared length of each word found on the list, so longer words are preferred.
+	squares int
+}
+
+// isBetter checks if the segmentation is better than the other one: it covers more characters using
+// words found on the list, then costs less and then holds longer words.
+func (s segmentation) isBetter(other segmentation) bool {
+	if s.uncovered != other.uncovered {
+		return s.uncovered < other.uncovered
+	}
+
+	if diff := s.cost - other.cost; diff < -1e-9 || diff > 1e-9 {
+		return diff < 0
+	}
+
+	return s.squares > other.squares
+}
+
+// with creates a new segmentation adding a part, found or not on the list, to the segmentation.
+func (s segmentation) with(part string, found bool, list lists.List) segmentation {
+	next := segmentation{parts: appendPart(s.parts, part), uncovered: s.uncovered, cost: s.cost, squares: s.squares}
+	if !found {
+		next.uncovered += len(part)
+		next.cost++
+		return next
+	}
+
+	next.cost += 1 / (1 + partWeight(part, list))
+	next.squares += len(part) * len(part)
+
+	return next
+}
+
+// bestSegmentation looks for the best segmentation of a hard word between every segmentation covered
+// by the list. Segmentations are compared as segmentation.isBetter does, and each part costs less
+// the more it weighs, so "this is a very long identifier" is preferred to "th isis avery long identifier".
+//
+// The search is solved using dynamic programming: for each position, it keeps the best segmentation
+// of the prefix ending on a word found on the list, and the best one ending on an unknown chunk.
+func bestSegmentation(token string, list lists.List) []string {
+	n := len(token)
+	endsOnWord := make([]*segmentation, n+1)
+	endsOnChunk := make([]*segmentation, n+1)
+	endsOnWord[0] = &segmentation{parts: []string{}}
+
+	keep := func(best []*segmentation, i int, candidate segmentation) {
+		if best[i] == nil || candidate.isBetter(*best[i]) {
+			best[i] = &candidate
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for _, from := range []*segmentation{endsOnWord[i], endsOnChunk[i]} {
+			if from == nil {
+				continue
+			}
+
+			for j := i + 1; j <= n; j++ {
+				if list.Contains(token[i:j]) {
+					keep(endsOnWord, j, from.with(token[i:j], true, list))
+				}
+			}
+		}
+
+		// unknown chunks start after a word found on the list, so consecutive unknown characters
+		// are kept together
+		if from := endsOnWord[i]; from != nil {
+			for j := i + 1; j <= n; j++ {
+				keep(endsOnChunk, j, from.with(token[i:j], false, list))
+			}
+		}
+	}
+
+	best := endsOnWord[n]
+	if best == nil || (endsOnChunk[n] != nil && endsOnChunk[n].isBetter(*best)) {
+		best = endsOnChunk[n]
+	}
+
+	return best.parts
+}
+
+// partWeight retrieves the weight of a word found on the list. Words found on lists.EnglishStop are the
+// most frequent English words, so they weigh at least one even when the list holds no weights.
+func partWeight(word string, list lists.List) float64 {
+	var weight float64
+	if weighted, ok := list.(lists.WeightedList); ok {
+		weight = weighted.Weight(word)
+	}
+	if weight < 1 && lists.EnglishStop.Contains(word) {
+		weight = 1
+	}
+
+	return weight
+}
+
+// appendPart appends a part to a copy of the given parts, so segmentations don't share their storage.
+func appendPart(parts []string, part string) []string {
+	copied := make([]string, len(parts), len(parts)+1)
+	copy(copied, parts)
+
+	return append(copied, part)
+}
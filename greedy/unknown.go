@@ -0,0 +1,40 @@
+package greedy
+
+import (
+	"strings"
+
+	"github.com/eroatta/token/conserv"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/samurai"
+	"github.com/eroatta/token/split"
+)
+
+// UnknownPolicy defines how a segment not found on the list is handled. It receives the segment with
+// its original case, and returns the parts that replace it, with offsets relative to the segment.
+type UnknownPolicy func(segment string) split.Result
+
+var (
+	// KeepUnknown keeps the unknown segment as a single part.
+	KeepUnknown UnknownPolicy = func(segment string) split.Result {
+		return split.Result{{Word: strings.ToLower(segment), Provenance: provenance}}
+	}
+
+	// ConservUnknown splits the unknown segment using Conserv.
+	ConservUnknown UnknownPolicy = func(segment string) split.Result {
+		return split.FromWords(segment, strings.Split(conserv.Split(segment), conserv.Separator), "conserv")
+	}
+
+	// DropUnknown discards the unknown segment.
+	DropUnknown UnknownPolicy = func(segment string) split.Result {
+		return split.Result{}
+	}
+)
+
+// SamuraiUnknown creates a policy that splits the unknown segment using Samurai, based on the given
+// context and lists of prefixes and suffixes.
+func SamuraiUnknown(tCtx samurai.TokenContext, prefixes lists.List, suffixes lists.List) UnknownPolicy {
+	return func(segment string) split.Result {
+		words := strings.Split(samurai.Split(segment, tCtx, prefixes, suffixes), samurai.Separator)
+		return split.FromWords(segment, words, "samurai")
+	}
+}
@@ -0,0 +1,230 @@
+// Package naming holds the splitting and expansion of identifiers shared by the lint and consistency
+// packages: identifiers are split on their soft words, and soft words are expanded only when they're
+// expanded with high confidence, which means that a single expansion was found. Multi-word expansions
+// are accepted only as acronyms, and every expansion must start on a word of the file.
+package naming
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"unicode"
+
+	"github.com/eroatta/token/amap"
+	"github.com/eroatta/token/basic"
+	"github.com/eroatta/token/conserv"
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/greedy"
+	"github.com/eroatta/token/split"
+)
+
+// Algorithm names accepted as splitter and expander.
+const (
+	Conserv = "conserv"
+	Greedy  = "greedy"
+	AMAP    = "amap"
+	Basic   = "basic"
+)
+
+// ErrUnknownAlgorithm indicates that the splitter or the expander is not supported.
+var ErrUnknownAlgorithm = errors.New("unknown algorithm")
+
+// Algorithms holds the algorithms used to split identifiers and to expand their soft words.
+type Algorithms struct {
+	Splitter string
+	Expander string
+}
+
+// Default returns the default algorithms: Conserv to split identifiers and AMAP to expand soft words.
+func Default() Algorithms {
+	return Algorithms{Splitter: Conserv, Expander: AMAP}
+}
+
+// Validate checks that both the splitter and the expander are supported, returning an
+// ErrUnknownAlgorithm error otherwise.
+func (a Algorithms) Validate() error {
+	switch strings.ToLower(a.Splitter) {
+	case Conserv, Greedy:
+	default:
+		return fmt.Errorf("%w: %q can't split identifiers, use conserv or greedy", ErrUnknownAlgorithm, a.Splitter)
+	}
+
+	switch strings.ToLower(a.Expander) {
+	case AMAP, Basic:
+	default:
+		return fmt.Errorf("%w: %q can't expand soft words, use amap or basic", ErrUnknownAlgorithm, a.Expander)
+	}
+
+	return nil
+}
+
+// Split splits the identifier on its lower case soft words, using the splitter.
+func (a Algorithms) Split(name string) split.Result {
+	if strings.ToLower(a.Splitter) == Greedy {
+		return greedy.SplitParts(name, greedy.DefaultList)
+	}
+
+	return split.FromWords(name, strings.Fields(conserv.Split(name)), Conserv)
+}
+
+// Expander expands a soft word of an identifier declared on a file, returning its long form, or an
+// empty string if it wasn't expanded with high confidence.
+type Expander func(file *ast.File, ident *ast.Ident, word string) string
+
+// NewExpander creates the expander for the identifiers declared on the files. AMAP uses the token scope
+// built from the file, and both AMAP and Basic use the comments of every file as the source of
+// expansions. The words of each file are kept, to check where the expansions start.
+func (a Algorithms) NewExpander(fset *token.FileSet, files []*ast.File) Expander {
+	var comments []string
+	for _, file := range files {
+		for _, group := range file.Comments {
+			comments = append(comments, group.Text())
+		}
+	}
+
+	fileWords := make(map[*ast.File]map[string]bool)
+	wordsOfFile := func(file *ast.File) map[string]bool {
+		if _, ok := fileWords[file]; !ok {
+			fileWords[file] = wordsOf(file)
+		}
+		return fileWords[file]
+	}
+
+	if strings.ToLower(a.Expander) == Basic {
+		srcWords := expansion.NewSetBuilder()
+		for _, comment := range comments {
+			srcWords.AddStrings(strings.FieldsFunc(strings.ToLower(comment), isNotLetter)...)
+		}
+		set := srcWords.Build()
+		noExpansions := expansion.NewSetBuilder().Build()
+
+		return func(file *ast.File, ident *ast.Ident, word string) string {
+			expansions, err := basic.TryExpand(word, set, nil, noExpansions)
+			return confident(word, expansions, err, wordsOfFile(file))
+		}
+	}
+
+	index := amap.NewReferenceIndex(comments)
+	return func(file *ast.File, ident *ast.Ident, word string) string {
+		scope := amap.ScopeFromFile(fset, file, ident.Pos())
+		expansions, err := amap.TryExpandWithIndex(word, scope, index)
+		return confident(word, expansions, err, wordsOfFile(file))
+	}
+}
+
+// confident returns the single expansion found for the word, or an empty string if none or many were
+// found. The word itself isn't an expansion, even when it's found on the comments.
+// AMAP can match whole sentences, such as the method name, or the middle of a word (i.e. "urrences
+// renamed in" for "uri"), so expansions holding several words that aren't acronyms of the word are
+// reduced to their only word abbreviated by the word, and expansions not starting on one of the given
+// words are discarded.
+func confident(word string, expansions []string, err error, fileWords map[string]bool) string {
+	if err != nil {
+		return ""
+	}
+
+	longForm := ""
+	for _, candidate := range expansions {
+		longFormWords := strings.Fields(candidate)
+		if len(longFormWords) > 1 && !isAcronym(word, longFormWords) {
+			candidate = abbreviatedWord(word, longFormWords)
+		}
+		if candidate == "" || candidate == word || candidate == longForm || !fileWords[strings.Fields(candidate)[0]] {
+			continue
+		}
+		if longForm != "" {
+			return ""
+		}
+		longForm = candidate
+	}
+
+	return longForm
+}
+
+// isAcronym checks if the word is written with the first letter of each long form word. Words starting
+// with "e" can be abbreviated with an "x", as "xml" for "extensible markup language".
+func isAcronym(word string, longFormWords []string) bool {
+	letters := []rune(word)
+	if len(letters) != len(longFormWords) {
+		return false
+	}
+
+	for i, longFormWord := range longFormWords {
+		first := []rune(longFormWord)[0]
+		if first != letters[i] && !(letters[i] == 'x' && first == 'e') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// abbreviatedWord retrieves the only long form word that starts with the first letter of the word and
+// holds its letters in order, or an empty string if none or many words do.
+func abbreviatedWord(word string, longFormWords []string) string {
+	found := ""
+	for _, longFormWord := range longFormWords {
+		if longFormWord == found || !abbreviates(word, longFormWord) {
+			continue
+		}
+		if found != "" {
+			return ""
+		}
+		found = longFormWord
+	}
+
+	return found
+}
+
+// abbreviates checks if the word starts with the first letter of the long form word and holds its
+// letters in order.
+func abbreviates(word string, longFormWord string) bool {
+	letters := []rune(word)
+	longFormLetters := []rune(longFormWord)
+	if len(letters) == 0 || len(longFormLetters) == 0 || letters[0] != longFormLetters[0] {
+		return false
+	}
+
+	i := 0
+	for _, r := range longFormLetters {
+		if i < len(letters) && r == letters[i] {
+			i++
+		}
+	}
+
+	return i == len(letters)
+}
+
+// wordsOf retrieves the lower case words found on the comments, identifiers and string literals of
+// the file.
+func wordsOf(file *ast.File) map[string]bool {
+	fileWords := make(map[string]bool)
+	add := func(text string) {
+		for _, word := range strings.FieldsFunc(strings.ToLower(text), isNotLetter) {
+			fileWords[word] = true
+		}
+	}
+
+	for _, group := range file.Comments {
+		add(group.Text())
+	}
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Ident:
+			add(conserv.Split(n.Name))
+		case *ast.BasicLit:
+			if n.Kind == token.STRING {
+				add(n.Value)
+			}
+		}
+		return true
+	})
+
+	return fileWords
+}
+
+func isNotLetter(r rune) bool {
+	return !unicode.IsLetter(r)
+}
@@ -0,0 +1,324 @@
+// Package lint provides an analyzer that reports poorly named identifiers, built on the splitting and
+// expansion algorithms. It can be run by go vet (see cmd/identlint) or by any driver of the
+// golang.org/x/tools/go/analysis framework.
+//
+// The analyzer runs the following checks on the declared identifiers:
+// * abbreviation: soft words that the expander (amap or basic) expands with high confidence, which
+// means that a single expansion was found, starting on a word of the file. Expansions holding several
+// words are accepted only as acronyms, otherwise the only word abbreviated by the soft word is taken. Only the soft words not found on lists.Dictionary, the
+// stop list or the project vocabulary are expanded, along with the known abbreviations.
+// A fix renames the identifier to its expanded form.
+// * unknown: soft words not found on those lists, which couldn't be expanded.
+// * inconsistent: long forms abbreviated using different short forms across the package. A fix renames
+// the identifier to use the most frequent short form.
+// * short: single letter names declared outside loops, except for method receivers.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/eroatta/token/casing"
+	"github.com/eroatta/token/internal/naming"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/split"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Available checks.
+const (
+	UnknownCheck      = "unknown"
+	AbbreviationCheck = "abbreviation"
+	InconsistentCheck = "inconsistent"
+	ShortCheck        = "short"
+)
+
+var availableChecks = []string{UnknownCheck, AbbreviationCheck, InconsistentCheck, ShortCheck}
+
+// ErrUnknownCheck indicates that a check set for the analyzer is not available.
+var ErrUnknownCheck = errors.New("unknown check")
+
+// ErrUnknownAlgorithm indicates that the splitter or the expander set for the analyzer is not supported.
+var ErrUnknownAlgorithm = naming.ErrUnknownAlgorithm
+
+var generated = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
+// Analyzer reports poorly named identifiers, using the default configuration. Its settings can be
+// changed through its flags.
+var Analyzer = NewAnalyzer()
+
+// NewAnalyzer creates an analyzer for poorly named identifiers. By default, every check is run,
+// identifiers are split with Conserv and expanded with AMAP.
+func NewAnalyzer(options ...Option) *analysis.Analyzer {
+	conf := newConfig(options)
+
+	analyzer := &analysis.Analyzer{
+		Name: "identlint",
+		Doc:  "reports poorly named identifiers: unknown words, abbreviations, inconsistent abbreviations and single letter names",
+		Run:  conf.run,
+	}
+	analyzer.Flags.Var(&conf.checks, "checks", "comma separated list of checks to run: unknown, abbreviation, inconsistent and short")
+	analyzer.Flags.StringVar(&conf.algorithms.Splitter, "splitter", conf.algorithms.Splitter, "algorithm used to split identifiers: conserv or greedy")
+	analyzer.Flags.StringVar(&conf.algorithms.Expander, "expander", conf.algorithms.Expander, "algorithm used to expand soft words: amap or basic")
+	analyzer.Flags.StringVar(&conf.vocabularyFile, "vocabulary", "", "file holding the project vocabulary, one word per line")
+	analyzer.Flags.Var(&conf.allowed, "allow", "comma separated list of single letter names allowed outside loops")
+
+	return analyzer
+}
+
+// occurrence is a soft word of an identifier that was expanded with high confidence.
+type occurrence struct {
+	ident     *ast.Ident
+	object    types.Object
+	parts     split.Result
+	index     int
+	shortForm string
+	longForm  string
+}
+
+func (c *config) run(pass *analysis.Pass) (interface{}, error) {
+	if err := c.algorithms.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := c.checks.validate(); err != nil {
+		return nil, err
+	}
+
+	vocabulary, err := c.projectVocabulary()
+	if err != nil {
+		return nil, err
+	}
+
+	expander := c.algorithms.NewExpander(pass.Fset, pass.Files)
+
+	var occurrences []occurrence
+	for _, file := range pass.Files {
+		if isGenerated(file) {
+			continue
+		}
+
+		exempted := exemptedNames(file)
+		ast.Inspect(file, func(node ast.Node) bool {
+			ident, ok := node.(*ast.Ident)
+			if !ok || ident.Name == "_" {
+				return true
+			}
+			object := pass.TypesInfo.Defs[ident]
+			if object == nil {
+				return true
+			}
+			if _, ok := object.(*types.PkgName); ok {
+				return true
+			}
+
+			if len([]rune(ident.Name)) == 1 {
+				if c.checks.contains(ShortCheck) && !exempted[ident] && !c.allowed.contains(ident.Name) {
+					report(pass, ident, ShortCheck, nil, "single letter name %q declared outside a loop", ident.Name)
+				}
+				return true
+			}
+
+			parts := c.algorithms.Split(ident.Name)
+			for i, part := range parts {
+				if len(part.Word) < 2 || isKnown(part.Word, vocabulary) {
+					continue
+				}
+
+				longForm := expander(file, ident, part.Word)
+				if longForm != "" {
+					occurrences = append(occurrences, occurrence{ident: ident, object: object, parts: parts, index: i,
+						shortForm: part.Word, longForm: longForm})
+					if c.checks.contains(AbbreviationCheck) {
+						fixes := renameFixes(pass, ident, object, replaceWord(ident.Name, parts, i, longForm))
+						report(pass, ident, AbbreviationCheck, fixes, "soft word %q of %s abbreviates %q",
+							part.Word, ident.Name, longForm)
+					}
+					continue
+				}
+
+				if c.checks.contains(UnknownCheck) {
+					report(pass, ident, UnknownCheck, nil, "soft word %q of %s not found on the dictionary",
+						part.Word, ident.Name)
+				}
+			}
+			return true
+		})
+	}
+
+	if c.checks.contains(InconsistentCheck) {
+		reportInconsistencies(pass, occurrences)
+	}
+
+	return nil, nil
+}
+
+// reportInconsistencies reports the identifiers that abbreviate a long form using a short form other
+// than the most frequent one on the package.
+func reportInconsistencies(pass *analysis.Pass, occurrences []occurrence) {
+	byLongForm := make(map[string]map[string]int)
+	for _, occ := range occurrences {
+		if byLongForm[occ.longForm] == nil {
+			byLongForm[occ.longForm] = make(map[string]int)
+		}
+		byLongForm[occ.longForm][occ.shortForm]++
+	}
+
+	for _, occ := range occurrences {
+		shortForms := byLongForm[occ.longForm]
+		if len(shortForms) < 2 {
+			continue
+		}
+
+		preferred := preferredShortForm(shortForms)
+		if occ.shortForm == preferred {
+			continue
+		}
+
+		fixes := renameFixes(pass, occ.ident, occ.object, replaceWord(occ.ident.Name, occ.parts, occ.index, preferred))
+		report(pass, occ.ident, InconsistentCheck, fixes,
+			"soft word %q of %s abbreviates %q, which is abbreviated as %q elsewhere on the package",
+			occ.shortForm, occ.ident.Name, occ.longForm, preferred)
+	}
+}
+
+// report reports a diagnostic for the identifier, found by the given check.
+func report(pass *analysis.Pass, ident *ast.Ident, check string, fixes []analysis.SuggestedFix, format string,
+	args ...interface{}) {
+	pass.Report(analysis.Diagnostic{
+		Pos:            ident.Pos(),
+		End:            ident.End(),
+		Category:       check,
+		Message:        fmt.Sprintf(format, args...),
+		SuggestedFixes: fixes,
+	})
+}
+
+// preferredShortForm retrieves the most frequent short form, choosing the first one in alphabetical
+// order on ties.
+func preferredShortForm(shortForms map[string]int) string {
+	forms := make([]string, 0, len(shortForms))
+	for form := range shortForms {
+		forms = append(forms, form)
+	}
+	sort.Slice(forms, func(i, j int) bool {
+		if shortForms[forms[i]] != shortForms[forms[j]] {
+			return shortForms[forms[i]] > shortForms[forms[j]]
+		}
+		return forms[i] < forms[j]
+	})
+
+	return forms[0]
+}
+
+// replaceWord writes the identifier replacing one of its soft words, using the identifier case style.
+func replaceWord(name string, parts split.Result, index int, replacement string) string {
+	words := make([]string, 0, len(parts))
+	for i, part := range parts {
+		if i == index {
+			words = append(words, strings.Fields(replacement)...)
+			continue
+		}
+		words = append(words, part.Word)
+	}
+
+	style := casing.Detect(name)
+	if style == casing.Mixed {
+		style = casing.Snake
+	}
+	if ast.IsExported(name) && style == casing.Camel {
+		style = casing.Pascal
+	}
+
+	return casing.Render(split.FromWords(strings.Join(words, ""), words, ""), style)
+}
+
+// renameFixes suggests renaming every use of the object on the package. Exported objects get no fixes,
+// as they can be used by other packages.
+func renameFixes(pass *analysis.Pass, ident *ast.Ident, object types.Object, name string) []analysis.SuggestedFix {
+	if object.Exported() || name == ident.Name {
+		return nil
+	}
+
+	var edits []analysis.TextEdit
+	for id, obj := range pass.TypesInfo.Defs {
+		if obj == object {
+			edits = append(edits, analysis.TextEdit{Pos: id.Pos(), End: id.End(), NewText: []byte(name)})
+		}
+	}
+	for id, obj := range pass.TypesInfo.Uses {
+		if obj == object {
+			edits = append(edits, analysis.TextEdit{Pos: id.Pos(), End: id.End(), NewText: []byte(name)})
+		}
+	}
+	sort.Slice(edits, func(i, j int) bool { return edits[i].Pos < edits[j].Pos })
+
+	return []analysis.SuggestedFix{{Message: fmt.Sprintf("Rename %s to %s", ident.Name, name), TextEdits: edits}}
+}
+
+// exemptedNames retrieves the identifiers allowed to have a single letter: the variables declared by
+// loops and the method receivers.
+func exemptedNames(file *ast.File) map[*ast.Ident]bool {
+	exempted := make(map[*ast.Ident]bool)
+	add := func(exprs ...ast.Expr) {
+		for _, expr := range exprs {
+			if ident, ok := expr.(*ast.Ident); ok {
+				exempted[ident] = true
+			}
+		}
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.ForStmt:
+			if assign, ok := n.Init.(*ast.AssignStmt); ok {
+				add(assign.Lhs...)
+			}
+		case *ast.RangeStmt:
+			add(n.Key, n.Value)
+		case *ast.FuncDecl:
+			if n.Recv != nil {
+				for _, field := range n.Recv.List {
+					for _, name := range field.Names {
+						exempted[name] = true
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	return exempted
+}
+
+// isKnown checks if the word is found on the dictionary, the stop list or the project vocabulary.
+// Words holding digits are considered known, as well as the dictionary words which are known
+// abbreviations.
+func isKnown(word string, vocabulary lists.List) bool {
+	if strings.IndexAny(word, "0123456789") >= 0 || lists.Stop.Contains(word) || vocabulary.Contains(word) {
+		return true
+	}
+
+	return lists.Dictionary.Contains(word) && !lists.KnownAbbreviations.Contains(word)
+}
+
+// isGenerated checks if the file holds generated code.
+func isGenerated(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			return false
+		}
+		for _, comment := range group.List {
+			if generated.MatchString(comment.Text) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
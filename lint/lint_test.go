@@ -0,0 +1,106 @@
+package lint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/eroatta/token/lists"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer_OnEachCheck_ShouldReportPoorlyNamedIdentifiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkg     string
+		options []Option
+	}{
+		{"short", "short", []Option{WithChecks(ShortCheck)}},
+		{"short_with_allowed_names", "allowed", []Option{WithChecks(ShortCheck), WithAllowedNames("w")}},
+		{"abbreviation_with_doc_comments", "confidence", []Option{WithChecks(AbbreviationCheck)}},
+		{"unknown_with_vocabulary", "unknown", []Option{WithChecks(UnknownCheck),
+			WithVocabulary(lists.NewBuilder().Add("tokend").Build())}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysistest.Run(t, analysistest.TestData(), NewAnalyzer(tt.options...), tt.pkg)
+		})
+	}
+}
+
+func TestAnalyzer_OnAbbreviations_ShouldSuggestRenames(t *testing.T) {
+	tests := []struct {
+		name    string
+		pkg     string
+		options []Option
+	}{
+		{"abbreviation", "abbreviation", []Option{WithChecks(AbbreviationCheck)}},
+		{"abbreviation_with_basic", "abbreviation", []Option{WithChecks(AbbreviationCheck), WithExpander("basic")}},
+		{"inconsistent", "inconsistent", []Option{WithChecks(InconsistentCheck)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), NewAnalyzer(tt.options...), tt.pkg)
+		})
+	}
+}
+
+func TestAnalyzer_OnFlags_ShouldChangeTheSettings(t *testing.T) {
+	analyzer := NewAnalyzer()
+	if err := analyzer.Flags.Parse([]string{"-checks", "short", "-allow", "w"}); err != nil {
+		t.Fatal(err)
+	}
+
+	analysistest.Run(t, analysistest.TestData(), analyzer, "allowed")
+}
+
+func TestAnalyzer_OnUnknownAlgorithms_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name  string
+		flags []string
+	}{
+		{"unknown_splitter", []string{"-splitter", "samurai"}},
+		{"unknown_expander", []string{"-expander", "amapp"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analyzer := NewAnalyzer()
+			assert.NoError(t, analyzer.Flags.Parse(tt.flags))
+
+			_, err := analyzer.Run(&analysis.Pass{})
+
+			assert.True(t, errors.Is(err, ErrUnknownAlgorithm))
+		})
+	}
+}
+
+func TestCheckSet_OnSet_ShouldRejectUnknownChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		err   error
+	}{
+		{"available_checks", "short,unknown", nil},
+		{"unknown_check", "short,shrot", ErrUnknownCheck},
+		{"no_checks", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var checks checkSet
+			err := checks.Set(tt.value)
+
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+}
+
+func TestAnalyzer_OnUnknownChecks_ShouldReturnError(t *testing.T) {
+	_, err := NewAnalyzer(WithChecks(ShortCheck, "abbreviations")).Run(&analysis.Pass{})
+
+	assert.True(t, errors.Is(err, ErrUnknownCheck))
+}
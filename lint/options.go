@@ -0,0 +1,162 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/eroatta/token/internal/naming"
+	"github.com/eroatta/token/lists"
+)
+
+// Option sets a setting for the analyzer.
+type Option func(*config)
+
+type config struct {
+	checks         checkSet
+	allowed        nameSet
+	algorithms     naming.Algorithms
+	vocabulary     lists.List
+	vocabularyFile string
+
+	once       sync.Once
+	loaded     lists.List
+	loadingErr error
+}
+
+func newConfig(options []Option) *config {
+	conf := &config{
+		checks:     checkSet{newNameSet(availableChecks...)},
+		allowed:    newNameSet(),
+		algorithms: naming.Default(),
+	}
+	for _, option := range options {
+		option(conf)
+	}
+
+	return conf
+}
+
+// WithChecks sets the checks to run, instead of every check. The analyzer fails with an ErrUnknownCheck
+// error if any of them is not available.
+func WithChecks(checks ...string) Option {
+	return func(c *config) {
+		c.checks = checkSet{newNameSet(checks...)}
+	}
+}
+
+// WithSplitter sets the algorithm used to split identifiers: conserv (the default) or greedy.
+func WithSplitter(algorithm string) Option {
+	return func(c *config) {
+		c.algorithms.Splitter = algorithm
+	}
+}
+
+// WithExpander sets the algorithm used to expand soft words: amap (the default) or basic.
+func WithExpander(algorithm string) Option {
+	return func(c *config) {
+		c.algorithms.Expander = algorithm
+	}
+}
+
+// WithVocabulary sets the project vocabulary, whose words are neither reported as unknown nor as
+// abbreviations.
+func WithVocabulary(vocabulary lists.List) Option {
+	return func(c *config) {
+		c.vocabulary = vocabulary
+	}
+}
+
+// WithAllowedNames sets the single letter names allowed outside loops, such as "w" and "r" for HTTP
+// handlers.
+func WithAllowedNames(names ...string) Option {
+	return func(c *config) {
+		c.allowed = newNameSet(names...)
+	}
+}
+
+// projectVocabulary retrieves the project vocabulary, joining the one set by WithVocabulary and the one
+// loaded from the vocabulary file, if any. The file is loaded once.
+func (c *config) projectVocabulary() (lists.List, error) {
+	c.once.Do(func() {
+		c.loaded = lists.NewBuilder().Build()
+		if c.vocabularyFile != "" {
+			c.loaded, c.loadingErr = lists.LoadFile(c.vocabularyFile)
+		}
+	})
+	if c.loadingErr != nil {
+		return nil, c.loadingErr
+	}
+
+	if c.vocabulary == nil {
+		return c.loaded, nil
+	}
+	return lists.Union(c.vocabulary, c.loaded), nil
+}
+
+// nameSet is a set of names, which can be set as a flag holding a comma separated list.
+type nameSet map[string]bool
+
+func newNameSet(names ...string) nameSet {
+	set := make(nameSet)
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			set[name] = true
+		}
+	}
+
+	return set
+}
+
+func (s nameSet) contains(name string) bool {
+	return s[name]
+}
+
+// String joins the names on the set, sorted.
+func (s nameSet) String() string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
+
+// Set replaces the names on the set by those on the comma separated list.
+func (s *nameSet) Set(value string) error {
+	*s = newNameSet(strings.Split(value, ",")...)
+	return nil
+}
+
+// checkSet is a set of check names, which can be set as a flag holding a comma separated list of the
+// available checks.
+type checkSet struct {
+	nameSet
+}
+
+// Set replaces the checks on the set by those on the comma separated list, returning an ErrUnknownCheck
+// error if any of them is not available.
+func (s *checkSet) Set(value string) error {
+	checks := checkSet{newNameSet(strings.Split(value, ",")...)}
+	if err := checks.validate(); err != nil {
+		return err
+	}
+
+	*s = checks
+	return nil
+}
+
+// validate checks that every name on the set is an available check, returning an ErrUnknownCheck
+// error otherwise.
+func (s checkSet) validate() error {
+	available := newNameSet(availableChecks...)
+	for _, name := range strings.Split(s.String(), ",") {
+		if name != "" && !available.contains(name) {
+			return fmt.Errorf("%w: %q, use %s", ErrUnknownCheck, name, strings.Join(availableChecks, ", "))
+		}
+	}
+
+	return nil
+}
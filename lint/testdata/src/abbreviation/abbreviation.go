@@ -0,0 +1,10 @@
+package abbreviation
+
+import "bytes"
+
+// flush writes the buffer contents.
+func flush() int {
+	buf := new(bytes.Buffer) // want `soft word "buf" of buf abbreviates "buffer"`
+	buf.WriteString("contents")
+	return buf.Len()
+}
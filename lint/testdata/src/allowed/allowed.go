@@ -0,0 +1,8 @@
+package allowed
+
+import "fmt"
+
+func show(w fmt.Stringer) {
+	x := w.String() // want `single letter name "x" declared outside a loop`
+	fmt.Println(x)
+}
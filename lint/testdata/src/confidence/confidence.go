@@ -0,0 +1,21 @@
+package confidence
+
+// scopeFromSource builds the scope from the source code.
+func scopeFromSource(src string) string { // want `soft word "src" of src abbreviates "source"`
+	return src
+}
+
+// occurrences counts the occurrences renamed in the document.
+func occurrences(uri string) int {
+	return len(uri)
+}
+
+// stringTokens writes the string tokens.
+func stringTokens(tt []string) int {
+	return len(tt)
+}
+
+// render writes the page using the hypertext markup language.
+func render(hml string) string { // want `soft word "hml" of hml abbreviates "hypertext markup language"`
+	return hml
+}
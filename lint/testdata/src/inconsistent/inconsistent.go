@@ -0,0 +1,13 @@
+package inconsistent
+
+// Configuration loading.
+
+type Configuration struct{}
+
+func load(cfg *Configuration) {}
+
+func reload(cfg *Configuration) {}
+
+func store(conf *Configuration) { // want `soft word "conf" of conf abbreviates "configuration", which is abbreviated as "cfg" elsewhere on the package`
+	_ = conf
+}
@@ -0,0 +1,21 @@
+package short
+
+import "fmt"
+
+type counter struct {
+	total int
+}
+
+func (c *counter) add(values []int) {
+	for i := 0; i < len(values); i++ {
+		c.total += values[i]
+	}
+	for _, v := range values {
+		c.total += v
+	}
+}
+
+func show(w fmt.Stringer) { // want `single letter name "w" declared outside a loop`
+	x := w.String() // want `single letter name "x" declared outside a loop`
+	fmt.Println(x)
+}
@@ -0,0 +1,9 @@
+package unknown
+
+var totalQwzx int // want `soft word "qwzx" of totalQwzx not found on the dictionary`
+
+var projectTokend int
+
+var httpStatus200 int
+
+var responseText string
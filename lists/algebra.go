@@ -0,0 +1,138 @@
+package lists
+
+import "strings"
+
+// Union creates a new list with the elements contained on any of the given lists.
+// As for every operation on this file, if any of the given lists is a WeightedList, the new list is
+// also weighted: each element takes its highest weight on the weighted lists holding it, or zero.
+func Union(lists ...List) List {
+	size := 0
+	for _, l := range lists {
+		size += l.Size()
+	}
+
+	elements := make(map[string]bool, size)
+	for _, l := range lists {
+		each(l, func(element string) {
+			elements[element] = true
+		})
+	}
+
+	return build(elements, lists...)
+}
+
+// Intersect creates a new list with the elements contained on every given list.
+func Intersect(first List, others ...List) List {
+	// iterate over the smallest list, checking the rest
+	smallest := first
+	for _, l := range others {
+		if l.Size() < smallest.Size() {
+			smallest = l
+		}
+	}
+
+	elements := make(map[string]bool)
+	each(smallest, func(element string) {
+		if first.Contains(element) && containedOnAll(element, others) {
+			elements[element] = true
+		}
+	})
+
+	return build(elements, append([]List{first}, others...)...)
+}
+
+// Difference creates a new list with the elements contained on the first list, but not on the others.
+func Difference(first List, others ...List) List {
+	elements := make(map[string]bool)
+	each(first, func(element string) {
+		for _, l := range others {
+			if l.Contains(element) {
+				return
+			}
+		}
+		elements[element] = true
+	})
+
+	return build(elements, first)
+}
+
+// Filter creates a new list with the elements of the given list that satisfy the predicate.
+func Filter(l List, keep func(string) bool) List {
+	elements := make(map[string]bool)
+	each(l, func(element string) {
+		if keep(element) {
+			elements[element] = true
+		}
+	})
+
+	return build(elements, l)
+}
+
+// build creates the list holding the elements, which is weighted if any of the given source lists is
+// weighted. Each element takes its highest weight on the weighted sources holding it, or zero.
+func build(elements map[string]bool, sources ...List) List {
+	weighted := make([]WeightedList, 0, len(sources))
+	for _, source := range sources {
+		if w, ok := asWeighted(source); ok {
+			weighted = append(weighted, w)
+		}
+	}
+	if len(weighted) == 0 {
+		return list{elements: elements}
+	}
+
+	weights := make(map[string]float64, len(elements))
+	for element := range elements {
+		weight, found := 0.0, false
+		for _, w := range weighted {
+			if w.Contains(element) && (!found || w.Weight(element) > weight) {
+				weight, found = w.Weight(element), true
+			}
+		}
+		weights[element] = weight
+	}
+
+	return weightedList{weights: weights}
+}
+
+// asWeighted retrieves the list as a WeightedList, if it holds weights. Lazy lists are weighted
+// only when the loaded list is.
+func asWeighted(l List) (WeightedList, bool) {
+	if lazy, ok := l.(*lazyList); ok {
+		return asWeighted(lazy.get())
+	}
+
+	weighted, ok := l.(WeightedList)
+	return weighted, ok
+}
+
+func containedOnAll(element string, lists []List) bool {
+	for _, l := range lists {
+		if !l.Contains(element) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// each calls the function for every element on the list, in lower case, avoiding the copy of
+// the elements for the lists provided by this package.
+func each(l List, fn func(string)) {
+	switch typed := l.(type) {
+	case list:
+		for element := range typed.elements {
+			fn(element)
+		}
+	case weightedList:
+		for element := range typed.weights {
+			fn(element)
+		}
+	case *lazyList:
+		each(typed.get(), fn)
+	default:
+		for _, element := range l.Elements() {
+			fn(strings.ToLower(element))
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package lists
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnion_ShouldReturnElementsOnAnyList(t *testing.T) {
+	first := NewBuilder().Add("one", "two").Build()
+	second := NewBuilder().Add("TWO", "three").Build()
+
+	got := Union(first, second)
+
+	assert.ElementsMatch(t, []string{"one", "two", "three"}, got.Elements())
+	assert.True(t, got.Contains("Three"))
+	assert.Equal(t, 2, first.Size(), "original list shouldn't be modified")
+}
+
+func TestIntersect_ShouldReturnElementsOnEveryList(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  []string
+		others [][]string
+		want   []string
+	}{
+		{"single_list", []string{"one", "two"}, nil, []string{"one", "two"}},
+		{"common_elements", []string{"one", "two", "three"}, [][]string{{"Two", "three", "four"}}, []string{"two", "three"}},
+		{"several_lists", []string{"one", "two", "three"}, [][]string{{"two", "three"}, {"three"}}, []string{"three"}},
+		{"no_common_elements", []string{"one"}, [][]string{{"two"}}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			others := make([]List, 0, len(tt.others))
+			for _, o := range tt.others {
+				others = append(others, NewBuilder().Add(o...).Build())
+			}
+
+			got := Intersect(NewBuilder().Add(tt.first...).Build(), others...)
+
+			assert.ElementsMatch(t, tt.want, got.Elements())
+		})
+	}
+}
+
+func TestDifference_ShouldReturnElementsOnlyOnFirstList(t *testing.T) {
+	first := NewBuilder().Add("func", "house", "car").Build()
+
+	got := Difference(first, NewBuilder().Add("FUNC").Build(), NewBuilder().Add("car").Build())
+
+	assert.ElementsMatch(t, []string{"house"}, got.Elements())
+	assert.Equal(t, 3, first.Size(), "original list shouldn't be modified")
+}
+
+func TestFilter_ShouldReturnElementsSatisfyingPredicate(t *testing.T) {
+	l := NewBuilder().Add("ab", "abc", "abcd").Build()
+
+	got := Filter(l, func(element string) bool { return len(element) > 2 })
+
+	assert.ElementsMatch(t, []string{"abc", "abcd"}, got.Elements())
+}
+
+func TestDifference_OnBuiltInLists_ShouldExcludeStopWords(t *testing.T) {
+	got := Difference(Dictionary, Stop)
+
+	assert.True(t, got.Contains("house"))
+	assert.False(t, got.Contains("for"))
+	assert.True(t, Dictionary.Contains("for"))
+}
+
+func TestFilter_OnCustomList_ShouldUseElements(t *testing.T) {
+	got := Filter(customList{"one", "Two"}, func(element string) bool { return strings.HasPrefix(element, "t") })
+
+	assert.ElementsMatch(t, []string{"two"}, got.Elements())
+}
+
+type customList []string
+
+func (c customList) Contains(element string) bool {
+	for _, e := range c {
+		if strings.EqualFold(e, element) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c customList) Size() int { return len(c) }
+
+func (c customList) Elements() []string { return c }
+
+func BenchmarkDifference(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Difference(Dictionary, Stop)
+	}
+}
+
+func TestUnion_OnWeightedLists_ShouldKeepHighestWeights(t *testing.T) {
+	weighted := NewWeightedBuilder().Add("one", 1).Add("two", 2).Build()
+	heavier := NewWeightedBuilder().Add("two", 5).Build()
+	plain := NewBuilder().Add("three").Build()
+
+	got, ok := Union(weighted, plain, heavier).(WeightedList)
+
+	assert.True(t, ok, "union should be weighted")
+	assert.Equal(t, 1.0, got.Weight("one"))
+	assert.Equal(t, 5.0, got.Weight("two"))
+	assert.Equal(t, 0.0, got.Weight("three"))
+	assert.True(t, got.Contains("three"))
+}
+
+func TestOperations_OnWeightedLists_ShouldKeepWeights(t *testing.T) {
+	weighted := NewWeightedBuilder().Add("one", 1).Add("two", 2).Add("three", 3).Build()
+	lazy := Lazy(func() List { return weighted })
+	others := NewBuilder().Add("two", "three").Build()
+
+	tests := []struct {
+		name string
+		got  List
+		want map[string]float64
+	}{
+		{"intersect", Intersect(weighted, others), map[string]float64{"two": 2, "three": 3}},
+		{"difference", Difference(weighted, others), map[string]float64{"one": 1}},
+		{"filter", Filter(weighted, func(e string) bool { return e != "two" }), map[string]float64{"one": 1, "three": 3}},
+		{"lazy", Union(lazy), map[string]float64{"one": 1, "two": 2, "three": 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.got.(WeightedList)
+
+			assert.True(t, ok, "result should be weighted")
+			assert.Equal(t, len(tt.want), got.Size())
+			for element, weight := range tt.want {
+				assert.Equal(t, weight, got.Weight(element))
+			}
+		})
+	}
+}
+
+func TestUnion_OnUnweightedLists_ShouldNotBeWeighted(t *testing.T) {
+	got := Union(NewBuilder().Add("one").Build(), Lazy(func() List { return NewBuilder().Add("two").Build() }))
+
+	_, ok := got.(WeightedList)
+	assert.False(t, ok)
+}
@@ -0,0 +1,122 @@
+package lists
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// English is the language code for the built-in aspell dictionary.
+const English = "en"
+
+// ErrUnknownLanguage indicates that no list has been registered for the requested language.
+var ErrUnknownLanguage = errors.New("unknown language")
+
+var dictionaries = struct {
+	sync.RWMutex
+	byLanguage map[string]List
+}{
+	byLanguage: map[string]List{English: Dictionary},
+}
+
+// RegisterDictionary registers a dictionary for the given language code (i.e. "es" or "pt").
+// Registering a dictionary for an already registered language replaces the previous one.
+func RegisterDictionary(language string, dictionary List) {
+	dictionaries.Lock()
+	defer dictionaries.Unlock()
+
+	dictionaries.byLanguage[language] = dictionary
+}
+
+// DictionaryFor retrieves the dictionary registered for the given language. If more than one
+// language is provided, the dictionaries are merged into a single list.
+func DictionaryFor(languages ...string) (List, error) {
+	dictionaries.RLock()
+	defer dictionaries.RUnlock()
+
+	found := make([]List, 0, len(languages))
+	for _, language := range languages {
+		dictionary, ok := dictionaries.byLanguage[language]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, language)
+		}
+		found = append(found, dictionary)
+	}
+
+	if len(found) == 1 {
+		return found[0], nil
+	}
+
+	builder := NewBuilder()
+	for _, dictionary := range found {
+		builder.Add(dictionary.Elements()...)
+	}
+
+	return builder.Build(), nil
+}
+
+var stopLists = map[string]List{
+	"go":         Stop,
+	"java":       NewBuilder().Add(javaStop...).Build(),
+	"python":     NewBuilder().Add(pythonStop...).Build(),
+	"csharp":     NewBuilder().Add(csharpStop...).Build(),
+	"javascript": NewBuilder().Add(javascriptStop...).Build(),
+	"cpp":        NewBuilder().Add(cppStop...).Build(),
+}
+
+// stopAliases maps common alternative names to the programming language names.
+var stopAliases = map[string]string{
+	"golang": "go",
+	"py":     "python",
+	"c#":     "csharp",
+	"cs":     "csharp",
+	"js":     "javascript",
+	"c++":    "cpp",
+}
+
+// StopFor retrieves the stop list for the given programming language, including its reserved words,
+// primitive types and standard library vocabulary. Supported languages are "go", "java", "python",
+// "csharp", "javascript" and "cpp". If more than one language is provided, the stop lists are merged
+// into a single list.
+func StopFor(languages ...string) (List, error) {
+	found := make([]List, 0, len(languages))
+	for _, language := range languages {
+		name := strings.ToLower(language)
+		if alias, ok := stopAliases[name]; ok {
+			name = alias
+		}
+
+		stop, ok := stopLists[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownLanguage, language)
+		}
+		found = append(found, stop)
+	}
+
+	if len(found) == 1 {
+		return found[0], nil
+	}
+
+	builder := NewBuilder()
+	for _, stop := range found {
+		builder.Add(stop.Elements()...)
+	}
+
+	return builder.Build(), nil
+}
+
+// Languages returns the codes of the languages with a registered dictionary, sorted alphabetically.
+func Languages() []string {
+	dictionaries.RLock()
+	defer dictionaries.RUnlock()
+
+	languages := make([]string, 0, len(dictionaries.byLanguage))
+	for language := range dictionaries.byLanguage {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	return languages
+}
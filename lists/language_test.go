@@ -0,0 +1,88 @@
+package lists
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryFor_OnRegisteredLanguages_ShouldReturnDictionary(t *testing.T) {
+	RegisterDictionary("xx", NewBuilder().Add("hola", "mundo").Build())
+	RegisterDictionary("yy", NewBuilder().Add("olá", "mundo").Build())
+
+	tests := []struct {
+		name      string
+		languages []string
+		token     string
+		want      bool
+	}{
+		{"english_by_default", []string{English}, "house", true},
+		{"single_language", []string{"xx"}, "hola", true},
+		{"single_language_without_word", []string{"xx"}, "olá", false},
+		{"mixed_languages", []string{"xx", "yy"}, "olá", true},
+		{"mixed_with_english", []string{"xx", English}, "house", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dictionary, err := DictionaryFor(tt.languages...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, dictionary.Contains(tt.token))
+		})
+	}
+}
+
+func TestDictionaryFor_OnUnknownLanguage_ShouldReturnError(t *testing.T) {
+	got, err := DictionaryFor(English, "unknown")
+
+	assert.Nil(t, got)
+	assert.True(t, errors.Is(err, ErrUnknownLanguage))
+}
+
+func TestLanguages_ShouldReturnRegisteredLanguages(t *testing.T) {
+	RegisterDictionary("zz", NewBuilder().Build())
+
+	got := Languages()
+
+	assert.Contains(t, got, English)
+	assert.Contains(t, got, "zz")
+}
+
+func TestStopFor_OnKnownLanguages_ShouldReturnStopList(t *testing.T) {
+	tests := []struct {
+		name      string
+		languages []string
+		token     string
+		want      bool
+	}{
+		{"go", []string{"go"}, "fallthrough", true},
+		{"java", []string{"java"}, "instanceof", true},
+		{"java_without_go_words", []string{"java"}, "fallthrough", false},
+		{"python", []string{"python"}, "lambda", true},
+		{"csharp", []string{"csharp"}, "foreach", true},
+		{"csharp_alias", []string{"C#"}, "foreach", true},
+		{"javascript", []string{"javascript"}, "typeof", true},
+		{"javascript_alias", []string{"js"}, "typeof", true},
+		{"cpp", []string{"cpp"}, "nullptr", true},
+		{"cpp_alias", []string{"c++"}, "nullptr", true},
+		{"mixed_languages", []string{"java", "python"}, "lambda", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stop, err := StopFor(tt.languages...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, stop.Contains(tt.token))
+		})
+	}
+}
+
+func TestStopFor_OnUnknownLanguage_ShouldReturnError(t *testing.T) {
+	got, err := StopFor("cobol")
+
+	assert.Nil(t, got)
+	assert.True(t, errors.Is(err, ErrUnknownLanguage))
+}
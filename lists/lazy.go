@@ -0,0 +1,98 @@
+package lists
+
+import (
+	"compress/gzip"
+	"embed"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+//go:embed data/*.txt.gz
+var assets embed.FS
+
+// Lazy creates a list that defers its creation until it's used for the first time.
+// The load function is called only once, and its result is kept for any further use.
+// The created list also implements WeightedList, retrieving the weights of the loaded list, or zero
+// if the loaded list is not weighted.
+func Lazy(load func() List) List {
+	return &lazyList{load: load}
+}
+
+type lazyList struct {
+	once sync.Once
+	load func() List
+	list List
+}
+
+func (l *lazyList) get() List {
+	l.once.Do(func() {
+		l.list = l.load()
+		l.load = nil
+	})
+
+	return l.list
+}
+
+func (l *lazyList) Contains(element string) bool {
+	return l.get().Contains(element)
+}
+
+func (l *lazyList) Size() int {
+	return l.get().Size()
+}
+
+func (l *lazyList) Elements() []string {
+	return l.get().Elements()
+}
+
+func (l *lazyList) Weight(element string) float64 {
+	if weighted, ok := l.get().(WeightedList); ok {
+		return weighted.Weight(element)
+	}
+
+	return 0.0
+}
+
+// LoadFile builds a list from a word list file, holding a single word on each line.
+// Files ending on ".gz" are decompressed using gzip.
+func LoadFile(path string) (List, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return loadCompressed(file, strings.HasSuffix(path, ".gz"))
+}
+
+// loadAsset builds a list from an embedded compressed word list. Embedded assets are part of the
+// binary, so any failure is considered a programming error.
+func loadAsset(name string) List {
+	file, err := assets.Open("data/" + name)
+	if err != nil {
+		panic("lists: missing embedded asset " + name + ": " + err.Error())
+	}
+	defer file.Close()
+
+	list, err := loadCompressed(file, true)
+	if err != nil {
+		panic("lists: invalid embedded asset " + name + ": " + err.Error())
+	}
+
+	return list
+}
+
+func loadCompressed(r io.Reader, compressed bool) (List, error) {
+	if compressed {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	return NewFromReader(r)
+}
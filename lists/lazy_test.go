@@ -0,0 +1,76 @@
+package lists
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy_ShouldLoadListOnFirstUseOnlyOnce(t *testing.T) {
+	calls := 0
+	list := Lazy(func() List {
+		calls++
+		return NewBuilder().Add("word").Build()
+	})
+
+	assert.Equal(t, 0, calls)
+	assert.True(t, list.Contains("WORD"))
+	assert.Equal(t, 1, list.Size())
+	assert.ElementsMatch(t, []string{"word"}, list.Elements())
+	assert.Equal(t, 1, calls)
+}
+
+func TestDictionary_ShouldLoadEmbeddedAsset(t *testing.T) {
+	assert.True(t, Dictionary.Contains("house"))
+	assert.True(t, Dictionary.Contains("yodeler's"))
+	assert.Equal(t, 120264, Dictionary.Size())
+}
+
+func TestNewFromReader_ShouldReturnListWithEveryWord(t *testing.T) {
+	got, err := NewFromReader(strings.NewReader("one\nTwo\n\n# comment\nthree\n"))
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"one", "two", "three"}, got.Elements())
+}
+
+func TestLoadFile_ShouldReturnListFromPlainAndCompressedFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	plain := filepath.Join(dir, "words.txt")
+	assert.NoError(t, os.WriteFile(plain, []byte("one\ntwo\n"), 0644))
+
+	compressed := filepath.Join(dir, "words.txt.gz")
+	file, err := os.Create(compressed)
+	assert.NoError(t, err)
+	gz := gzip.NewWriter(file)
+	gz.Write([]byte("three\nfour\n"))
+	gz.Close()
+	file.Close()
+
+	got, err := LoadFile(plain)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"one", "two"}, got.Elements())
+
+	got, err = LoadFile(compressed)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"three", "four"}, got.Elements())
+}
+
+func TestLoadFile_OnMissingFile_ShouldReturnError(t *testing.T) {
+	got, err := LoadFile(filepath.Join(t.TempDir(), "missing.txt"))
+
+	assert.Nil(t, got)
+	assert.Error(t, err)
+}
+
+func TestLazy_OnWeightedList_ShouldForwardWeights(t *testing.T) {
+	weighted := Lazy(func() List { return NewWeightedBuilder().Add("now", 10).Build() }).(WeightedList)
+	plain := Lazy(func() List { return NewBuilder().Add("now").Build() }).(WeightedList)
+
+	assert.Equal(t, 10.0, weighted.Weight("now"))
+	assert.Equal(t, 0.0, plain.Weight("now"))
+}
@@ -7,11 +7,16 @@ import (
 
 var (
 	// Dictionary is a list of words extracted from the aspell GNU tool.
-	Dictionary = NewBuilder().Add(dictionary...).Build()
+	// It's loaded from an embedded compressed asset the first time it's used.
+	Dictionary = Lazy(func() List { return loadAsset("dictionary.txt.gz") })
 	// KnownAbbreviations is a list of strings that are known and common abbreviations on the language.
 	KnownAbbreviations = NewBuilder().Add(knownAbbreviations...).Build()
 	// Stop is a list of reserved words, data types and Go library names.
+	// Stop lists for other programming languages are available through StopFor.
 	Stop = NewBuilder().Add(stop...).Build()
+	// EnglishStop is a list of English function words, such as "the", "of" and "and", which are common
+	// on comments and documentation but carry no meaning on their own.
+	EnglishStop = NewBuilder().Add(englishStop...).Build()
 	// Prefixes is a list of common prefixes.
 	Prefixes = NewBuilder().Add(prefixes...).Build()
 	// Suffixes is a list of common suffixes.
@@ -124,3 +124,24 @@ func TestBuild_OnListBuilder_ShouldIncludeProvidedElements(t *testing.T) {
 		})
 	}
 }
+
+func TestEnglishStop_ShouldHoldFunctionWordsOnly(t *testing.T) {
+	tests := []struct {
+		word string
+		want bool
+	}{
+		{"the", true},
+		{"of", true},
+		{"and", true},
+		{"To", true},
+		{"func", false},
+		{"string", false},
+		{"configuration", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			assert.Equal(t, tt.want, EnglishStop.Contains(tt.word))
+		})
+	}
+}
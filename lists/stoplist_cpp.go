@@ -0,0 +1,180 @@
+package lists
+
+var cppStop = []string{
+	"alignas",
+	"alignof",
+	"and",
+	"and_eq",
+	"asm",
+	"auto",
+	"bitand",
+	"bitor",
+	"bool",
+	"break",
+	"case",
+	"catch",
+	"char",
+	"char8_t",
+	"char16_t",
+	"char32_t",
+	"class",
+	"compl",
+	"concept",
+	"const",
+	"consteval",
+	"constexpr",
+	"constinit",
+	"const_cast",
+	"continue",
+	"co_await",
+	"co_return",
+	"co_yield",
+	"decltype",
+	"default",
+	"delete",
+	"do",
+	"double",
+	"dynamic_cast",
+	"else",
+	"enum",
+	"explicit",
+	"export",
+	"extern",
+	"false",
+	"float",
+	"for",
+	"friend",
+	"goto",
+	"if",
+	"inline",
+	"int",
+	"long",
+	"mutable",
+	"namespace",
+	"new",
+	"noexcept",
+	"not",
+	"not_eq",
+	"nullptr",
+	"operator",
+	"or",
+	"or_eq",
+	"private",
+	"protected",
+	"public",
+	"register",
+	"reinterpret_cast",
+	"requires",
+	"return",
+	"short",
+	"signed",
+	"sizeof",
+	"static",
+	"static_assert",
+	"static_cast",
+	"struct",
+	"switch",
+	"template",
+	"this",
+	"thread_local",
+	"throw",
+	"true",
+	"try",
+	"typedef",
+	"typeid",
+	"typename",
+	"union",
+	"unsigned",
+	"using",
+	"virtual",
+	"void",
+	"volatile",
+	"wchar_t",
+	"while",
+	"xor",
+	"xor_eq",
+	"override",
+	"final",
+	"include",
+	"define",
+	"ifdef",
+	"ifndef",
+	"endif",
+	"pragma",
+	"int8_t",
+	"int16_t",
+	"int32_t",
+	"int64_t",
+	"uint8_t",
+	"uint16_t",
+	"uint32_t",
+	"uint64_t",
+	"size_t",
+	"ptrdiff_t",
+	"nullptr_t",
+	"std",
+	"string",
+	"vector",
+	"list",
+	"deque",
+	"map",
+	"multimap",
+	"unordered_map",
+	"set",
+	"multiset",
+	"unordered_set",
+	"array",
+	"pair",
+	"tuple",
+	"optional",
+	"variant",
+	"any",
+	"stack",
+	"queue",
+	"priority_queue",
+	"bitset",
+	"iterator",
+	"algorithm",
+	"memory",
+	"shared_ptr",
+	"unique_ptr",
+	"weak_ptr",
+	"make_shared",
+	"make_unique",
+	"move",
+	"forward",
+	"swap",
+	"function",
+	"thread",
+	"mutex",
+	"atomic",
+	"chrono",
+	"iostream",
+	"fstream",
+	"sstream",
+	"cout",
+	"cin",
+	"cerr",
+	"endl",
+	"printf",
+	"scanf",
+	"malloc",
+	"free",
+	"memcpy",
+	"strlen",
+	"cstdio",
+	"cstdlib",
+	"cstring",
+	"cmath",
+	"exception",
+	"runtime_error",
+	"begin",
+	"end",
+	"size",
+	"empty",
+	"push_back",
+	"emplace_back",
+	"insert",
+	"erase",
+	"find",
+	"sort"}
@@ -0,0 +1,160 @@
+package lists
+
+var csharpStop = []string{
+	"abstract",
+	"as",
+	"base",
+	"bool",
+	"break",
+	"byte",
+	"case",
+	"catch",
+	"char",
+	"checked",
+	"class",
+	"const",
+	"continue",
+	"decimal",
+	"default",
+	"delegate",
+	"do",
+	"double",
+	"else",
+	"enum",
+	"event",
+	"explicit",
+	"extern",
+	"false",
+	"finally",
+	"fixed",
+	"float",
+	"for",
+	"foreach",
+	"goto",
+	"if",
+	"implicit",
+	"in",
+	"int",
+	"interface",
+	"internal",
+	"is",
+	"lock",
+	"long",
+	"namespace",
+	"new",
+	"null",
+	"object",
+	"operator",
+	"out",
+	"override",
+	"params",
+	"private",
+	"protected",
+	"public",
+	"readonly",
+	"ref",
+	"return",
+	"sbyte",
+	"sealed",
+	"short",
+	"sizeof",
+	"stackalloc",
+	"static",
+	"string",
+	"struct",
+	"switch",
+	"this",
+	"throw",
+	"true",
+	"try",
+	"typeof",
+	"uint",
+	"ulong",
+	"unchecked",
+	"unsafe",
+	"ushort",
+	"using",
+	"virtual",
+	"void",
+	"volatile",
+	"while",
+	"add",
+	"alias",
+	"ascending",
+	"async",
+	"await",
+	"by",
+	"descending",
+	"dynamic",
+	"equals",
+	"from",
+	"get",
+	"global",
+	"group",
+	"into",
+	"join",
+	"let",
+	"nameof",
+	"on",
+	"orderby",
+	"partial",
+	"record",
+	"remove",
+	"select",
+	"set",
+	"value",
+	"var",
+	"when",
+	"where",
+	"yield",
+	"init",
+	"nint",
+	"nuint",
+	"system",
+	"collections",
+	"generic",
+	"linq",
+	"text",
+	"threading",
+	"tasks",
+	"io",
+	"net",
+	"http",
+	"diagnostics",
+	"reflection",
+	"runtime",
+	"serialization",
+	"security",
+	"cryptography",
+	"globalization",
+	"configuration",
+	"data",
+	"xml",
+	"json",
+	"console",
+	"math",
+	"convert",
+	"environment",
+	"exception",
+	"list",
+	"dictionary",
+	"hashset",
+	"queue",
+	"stack",
+	"ienumerable",
+	"ienumerator",
+	"icollection",
+	"ilist",
+	"idictionary",
+	"task",
+	"action",
+	"func",
+	"stringbuilder",
+	"datetime",
+	"timespan",
+	"guid",
+	"tostring",
+	"gethashcode",
+	"gettype",
+	"writeline",
+	"readline"}
@@ -0,0 +1,141 @@
+package lists
+
+var javaStop = []string{
+	"abstract",
+	"assert",
+	"boolean",
+	"break",
+	"byte",
+	"case",
+	"catch",
+	"char",
+	"class",
+	"const",
+	"continue",
+	"default",
+	"do",
+	"double",
+	"else",
+	"enum",
+	"extends",
+	"final",
+	"finally",
+	"float",
+	"for",
+	"goto",
+	"if",
+	"implements",
+	"import",
+	"instanceof",
+	"int",
+	"interface",
+	"long",
+	"native",
+	"new",
+	"package",
+	"private",
+	"protected",
+	"public",
+	"return",
+	"short",
+	"static",
+	"strictfp",
+	"super",
+	"switch",
+	"synchronized",
+	"this",
+	"throw",
+	"throws",
+	"transient",
+	"try",
+	"void",
+	"volatile",
+	"while",
+	"var",
+	"record",
+	"yield",
+	"sealed",
+	"permits",
+	"true",
+	"false",
+	"null",
+	"string",
+	"object",
+	"integer",
+	"character",
+	"math",
+	"system",
+	"thread",
+	"runnable",
+	"exception",
+	"throwable",
+	"error",
+	"override",
+	"deprecated",
+	"java",
+	"lang",
+	"util",
+	"io",
+	"nio",
+	"net",
+	"sql",
+	"time",
+	"text",
+	"awt",
+	"swing",
+	"concurrent",
+	"atomic",
+	"locks",
+	"function",
+	"stream",
+	"regex",
+	"reflect",
+	"annotation",
+	"security",
+	"crypto",
+	"beans",
+	"rmi",
+	"logging",
+	"zip",
+	"jar",
+	"list",
+	"arraylist",
+	"linkedlist",
+	"map",
+	"hashmap",
+	"treemap",
+	"set",
+	"hashset",
+	"treeset",
+	"collection",
+	"collections",
+	"arrays",
+	"iterator",
+	"iterable",
+	"optional",
+	"stringbuilder",
+	"stringbuffer",
+	"scanner",
+	"file",
+	"path",
+	"paths",
+	"files",
+	"inputstream",
+	"outputstream",
+	"reader",
+	"writer",
+	"bufferedreader",
+	"bufferedwriter",
+	"printstream",
+	"serializable",
+	"comparable",
+	"comparator",
+	"cloneable",
+	"autocloseable",
+	"closeable",
+	"println",
+	"printf",
+	"tostring",
+	"hashcode",
+	"equals",
+	"getclass"}
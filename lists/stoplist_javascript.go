@@ -0,0 +1,134 @@
+package lists
+
+var javascriptStop = []string{
+	"await",
+	"break",
+	"case",
+	"catch",
+	"class",
+	"const",
+	"continue",
+	"debugger",
+	"default",
+	"delete",
+	"do",
+	"else",
+	"enum",
+	"export",
+	"extends",
+	"false",
+	"finally",
+	"for",
+	"function",
+	"if",
+	"implements",
+	"import",
+	"in",
+	"instanceof",
+	"interface",
+	"let",
+	"new",
+	"null",
+	"package",
+	"private",
+	"protected",
+	"public",
+	"return",
+	"static",
+	"super",
+	"switch",
+	"this",
+	"throw",
+	"true",
+	"try",
+	"typeof",
+	"var",
+	"void",
+	"while",
+	"with",
+	"yield",
+	"async",
+	"of",
+	"get",
+	"set",
+	"undefined",
+	"nan",
+	"infinity",
+	"arguments",
+	"eval",
+	"number",
+	"string",
+	"boolean",
+	"symbol",
+	"bigint",
+	"object",
+	"array",
+	"date",
+	"regexp",
+	"error",
+	"map",
+	"weakmap",
+	"weakset",
+	"promise",
+	"proxy",
+	"reflect",
+	"json",
+	"math",
+	"console",
+	"window",
+	"document",
+	"navigator",
+	"location",
+	"history",
+	"localstorage",
+	"sessionstorage",
+	"settimeout",
+	"setinterval",
+	"cleartimeout",
+	"clearinterval",
+	"fetch",
+	"require",
+	"module",
+	"exports",
+	"process",
+	"buffer",
+	"global",
+	"prototype",
+	"constructor",
+	"length",
+	"push",
+	"pop",
+	"shift",
+	"unshift",
+	"slice",
+	"splice",
+	"concat",
+	"join",
+	"indexof",
+	"foreach",
+	"filter",
+	"reduce",
+	"keys",
+	"values",
+	"entries",
+	"assign",
+	"freeze",
+	"parse",
+	"stringify",
+	"tostring",
+	"valueof",
+	"then",
+	"resolve",
+	"reject",
+	"fs",
+	"path",
+	"http",
+	"https",
+	"url",
+	"util",
+	"events",
+	"stream",
+	"os",
+	"crypto",
+	"child_process",
+	"querystring"}
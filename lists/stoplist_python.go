@@ -0,0 +1,171 @@
+package lists
+
+var pythonStop = []string{
+	"and",
+	"as",
+	"assert",
+	"async",
+	"await",
+	"break",
+	"class",
+	"continue",
+	"def",
+	"del",
+	"elif",
+	"else",
+	"except",
+	"finally",
+	"for",
+	"from",
+	"global",
+	"if",
+	"import",
+	"in",
+	"is",
+	"lambda",
+	"nonlocal",
+	"not",
+	"or",
+	"pass",
+	"raise",
+	"return",
+	"try",
+	"while",
+	"with",
+	"yield",
+	"none",
+	"true",
+	"false",
+	"self",
+	"cls",
+	"match",
+	"case",
+	"int",
+	"float",
+	"complex",
+	"str",
+	"bytes",
+	"bytearray",
+	"bool",
+	"list",
+	"tuple",
+	"dict",
+	"set",
+	"frozenset",
+	"range",
+	"object",
+	"type",
+	"memoryview",
+	"abs",
+	"all",
+	"any",
+	"ascii",
+	"bin",
+	"callable",
+	"chr",
+	"classmethod",
+	"compile",
+	"delattr",
+	"dir",
+	"divmod",
+	"enumerate",
+	"eval",
+	"exec",
+	"filter",
+	"format",
+	"getattr",
+	"globals",
+	"hasattr",
+	"hash",
+	"help",
+	"hex",
+	"id",
+	"input",
+	"isinstance",
+	"issubclass",
+	"iter",
+	"len",
+	"locals",
+	"map",
+	"max",
+	"min",
+	"next",
+	"oct",
+	"open",
+	"ord",
+	"pow",
+	"print",
+	"property",
+	"repr",
+	"reversed",
+	"round",
+	"setattr",
+	"slice",
+	"sorted",
+	"staticmethod",
+	"sum",
+	"super",
+	"vars",
+	"zip",
+	"init",
+	"main",
+	"os",
+	"sys",
+	"re",
+	"io",
+	"json",
+	"math",
+	"random",
+	"datetime",
+	"time",
+	"collections",
+	"itertools",
+	"functools",
+	"operator",
+	"pathlib",
+	"shutil",
+	"subprocess",
+	"threading",
+	"multiprocessing",
+	"asyncio",
+	"socket",
+	"logging",
+	"argparse",
+	"unittest",
+	"typing",
+	"dataclasses",
+	"abc",
+	"copy",
+	"pickle",
+	"csv",
+	"sqlite3",
+	"urllib",
+	"http",
+	"email",
+	"hashlib",
+	"hmac",
+	"base64",
+	"struct",
+	"string",
+	"textwrap",
+	"tempfile",
+	"glob",
+	"fnmatch",
+	"uuid",
+	"decimal",
+	"fractions",
+	"statistics",
+	"heapq",
+	"bisect",
+	"queue",
+	"contextlib",
+	"traceback",
+	"warnings",
+	"inspect",
+	"enum",
+	"pprint",
+	"secrets",
+	"zlib",
+	"gzip",
+	"tarfile",
+	"zipfile"}
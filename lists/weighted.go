@@ -0,0 +1,104 @@
+package lists
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WeightedList declares the contract for a list that stores a weight for each word, such as its
+// frequency on a corpus or a priority tier.
+type WeightedList interface {
+	List
+	// Weight returns the weight for a word, or zero if the word is not contained on the list.
+	Weight(string) float64
+}
+
+type weightedList struct {
+	weights map[string]float64
+}
+
+func (l weightedList) Contains(element string) bool {
+	_, ok := l.weights[strings.ToLower(element)]
+	return ok
+}
+
+func (l weightedList) Size() int {
+	return len(l.weights)
+}
+
+func (l weightedList) Elements() []string {
+	keys := make([]string, 0, len(l.weights))
+	for k := range l.weights {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func (l weightedList) Weight(element string) float64 {
+	return l.weights[strings.ToLower(element)]
+}
+
+// NewWeightedBuilder creates a new WeightedListBuilder.
+func NewWeightedBuilder() WeightedListBuilder {
+	return &weightedListBuilder{
+		weights: make(map[string]float64),
+	}
+}
+
+// WeightedListBuilder builds a weighted list based on the added elements.
+type WeightedListBuilder interface {
+	// Add adds an element to the list with the given weight. If the element was already added,
+	// its weight is replaced.
+	Add(string, float64) WeightedListBuilder
+	// Build creates the weighted list with the given elements.
+	Build() WeightedList
+}
+
+type weightedListBuilder struct {
+	weights map[string]float64
+}
+
+func (wb *weightedListBuilder) Add(element string, weight float64) WeightedListBuilder {
+	wb.weights[strings.ToLower(element)] = weight
+	return wb
+}
+
+func (wb *weightedListBuilder) Build() WeightedList {
+	return weightedList{weights: wb.weights}
+}
+
+// NewWeightedFromReader builds a weighted list from a reader, where each line holds a word and its weight
+// separated by spaces or tabs (i.e. "the 23135851162"). Empty lines or lines starting with # are ignored.
+func NewWeightedFromReader(r io.Reader) (WeightedList, error) {
+	builder := NewWeightedBuilder()
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected word and weight, found %q", n, line)
+		}
+
+		weight, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		builder.Add(fields[0], weight)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return builder.Build(), nil
+}
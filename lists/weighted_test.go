@@ -0,0 +1,64 @@
+package lists
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeight_OnWeightedList_ShouldRetrieveWeight(t *testing.T) {
+	list := NewWeightedBuilder().Add("The", 100).Add("of", 80).Add("the", 120).Build()
+
+	tests := []struct {
+		name     string
+		token    string
+		want     float64
+		contains bool
+	}{
+		{"replaced_weight", "the", 120, true},
+		{"case_insensitive", "OF", 80, true},
+		{"missing_word", "any", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, list.Weight(tt.token))
+			assert.Equal(t, tt.contains, list.Contains(tt.token))
+		})
+	}
+
+	assert.Equal(t, 2, list.Size())
+	assert.ElementsMatch(t, []string{"the", "of"}, list.Elements())
+}
+
+func TestNewWeightedFromReader_ShouldReturnWeightedList(t *testing.T) {
+	input := "# word frequencies\nthe 23135851162\nof\t13151942776\n\nconfig 0.5\n"
+
+	got, err := NewWeightedFromReader(strings.NewReader(input))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, got.Size())
+	assert.Equal(t, 23135851162.0, got.Weight("the"))
+	assert.Equal(t, 0.5, got.Weight("config"))
+}
+
+func TestNewWeightedFromReader_OnInvalidLine_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"missing_weight", "the\n", "line 1: expected word and weight, found \"the\""},
+		{"invalid_weight", "the 1\nof many\n", "line 2: strconv.ParseFloat: parsing \"many\": invalid syntax"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewWeightedFromReader(strings.NewReader(tt.input))
+
+			assert.Nil(t, got)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package lists
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// NewFromReader builds a list from a reader, where each line holds a single word.
+// Empty lines or lines starting with # are ignored.
+func NewFromReader(r io.Reader) (List, error) {
+	return load(r, false)
+}
+
+// LoadAspell builds a list from an aspell word list, as the one produced by "aspell dump master".
+// Each line holds a single word, and empty lines or lines starting with # are ignored.
+func LoadAspell(r io.Reader) (List, error) {
+	return NewFromReader(r)
+}
+
+// LoadHunspell builds a list from a hunspell dictionary (.dic) file.
+// The first line holds the approximate number of words and it's skipped. Each remaining line holds
+// a word, optionally followed by its affix flags ("word/FLAGS"), which are discarded.
+func LoadHunspell(r io.Reader) (List, error) {
+	return load(r, true)
+}
+
+func load(r io.Reader, hunspell bool) (List, error) {
+	builder := NewBuilder()
+
+	scanner := bufio.NewScanner(r)
+	first := true
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if first && hunspell {
+			first = false
+			continue
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if hunspell {
+			// strip affix flags and morphological fields
+			if i := strings.IndexAny(line, "/\t "); i >= 0 {
+				line = line[:i]
+			}
+		}
+
+		builder.Add(line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return builder.Build(), nil
+}
@@ -0,0 +1,26 @@
+package lists
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadAspell_ShouldReturnListWithEveryWord(t *testing.T) {
+	input := "# aspell word list\ncasa\n\nPerro\ngato\n"
+
+	got, err := LoadAspell(strings.NewReader(input))
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"casa", "perro", "gato"}, got.Elements())
+}
+
+func TestLoadHunspell_ShouldReturnListWithoutFlags(t *testing.T) {
+	input := "4\ncasa/S\nperro/SM\ngato\nárbol/S\tpo:noun\n"
+
+	got, err := LoadHunspell(strings.NewReader(input))
+
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"casa", "perro", "gato", "árbol"}, got.Elements())
+}
@@ -0,0 +1,269 @@
+package lsp
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/eroatta/token/amap"
+	"github.com/eroatta/token/casing"
+	"github.com/eroatta/token/gentest"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/service"
+	"github.com/eroatta/token/split"
+)
+
+// insight holds the split and the expansion of an identifier found on a document.
+type insight struct {
+	ident *ast.Ident
+	// start and end are the byte offsets of the identifier on the document.
+	start int
+	end   int
+	// parts holds the soft words produced by the splitter.
+	parts split.Result
+	// candidates holds the expansions found for each soft word, sorted by rank. GenTest expands the
+	// whole identifier, so its expansion is found under the identifier name.
+	candidates map[string][]string
+	// expanded holds the soft words of the expanded identifier.
+	expanded []string
+	// splitErr and expandErr hold the reasons the identifier couldn't be split or expanded.
+	splitErr  string
+	expandErr string
+	// file is the parsed document, used to look for the identifier occurrences.
+	file *ast.File
+	// qualified indicates that the identifier is the selected name of a selector, such as Sprintf on
+	// fmt.Sprintf.
+	qualified bool
+}
+
+// inspect splits and expands the identifier found at the given offset of a Go document.
+// The token scope and the context used by the expanders are built from the document.
+// It returns nil if there's no identifier at the offset.
+func (s *Server) inspect(filename string, text string, offset int) *insight {
+	fset := token.NewFileSet()
+	// the document is usually being edited, so a partial syntax tree is used if it can't be parsed
+	file, _ := parser.ParseFile(fset, filename, text, parser.ParseComments|parser.AllErrors)
+	if file == nil {
+		return nil
+	}
+
+	tokFile := fset.File(file.Pos())
+	if offset < 0 || offset > tokFile.Size() {
+		return nil
+	}
+	pos := tokFile.Pos(offset)
+
+	var ident *ast.Ident
+	var qualified bool
+	ast.Inspect(file, func(node ast.Node) bool {
+		if node == nil || pos < node.Pos() || pos > node.End() {
+			return false
+		}
+		switch n := node.(type) {
+		case *ast.Ident:
+			if n.Name != "_" {
+				ident, qualified = n, false
+			}
+		case *ast.SelectorExpr:
+			if pos >= n.Sel.Pos() && pos <= n.Sel.End() {
+				ident, qualified = n.Sel, true
+				return false
+			}
+		}
+		return true
+	})
+	if ident == nil {
+		return nil
+	}
+
+	in := &insight{
+		ident:      ident,
+		start:      tokFile.Offset(ident.Pos()),
+		end:        tokFile.Offset(ident.End()),
+		candidates: make(map[string][]string),
+		file:       file,
+		qualified:  qualified,
+	}
+
+	context := gentest.ContextFromFile(file, ident.Pos())
+	results, err := s.svc.Split(service.SplitRequest{
+		Algorithm: s.splitter,
+		Tokens:    []string{ident.Name},
+		Context:   context.Elements(),
+	})
+	switch {
+	case err != nil:
+		in.splitErr = err.Error()
+	case results[0].Error != "":
+		in.splitErr = results[0].Error
+	default:
+		in.parts = results[0].Parts
+	}
+
+	switch strings.ToLower(s.expander) {
+	case service.AMAP:
+		s.expandWords(in, amap.ScopeFromFile(fset, file, ident.Pos()), commentsOf(file))
+	case service.GenTest:
+		s.expandIdentifier(in, context)
+	default:
+		in.expandErr = fmt.Sprintf("%v: %q can't expand tokens", service.ErrUnknownAlgorithm, s.expander)
+	}
+
+	return in
+}
+
+// expandWords expands each soft word of the identifier using AMAP, keeping the words found on
+// lists.Stop and the single letter words.
+func (s *Server) expandWords(in *insight, scope amap.TokenScope, referenceText []string) {
+	index := amap.NewReferenceIndex(referenceText)
+	for _, part := range in.parts {
+		if len(part.Word) < 2 || lists.Stop.Contains(part.Word) {
+			in.expanded = append(in.expanded, part.Word)
+			continue
+		}
+
+		expansions, err := amap.TryExpandWithIndex(part.Word, scope, index)
+		if err != nil || len(expansions) == 0 || expansions[0] == part.Word {
+			in.expanded = append(in.expanded, part.Word)
+			continue
+		}
+
+		in.candidates[part.Word] = expansions
+		in.expanded = append(in.expanded, strings.Fields(expansions[0])...)
+	}
+}
+
+// expandIdentifier expands the whole identifier using GenTest. The identifier is written in lower case,
+// as GenTest looks for the expansions of the soft words without changing their case.
+func (s *Server) expandIdentifier(in *insight, context lists.List) {
+	results, err := s.svc.Expand(service.ExpandRequest{
+		Algorithm: service.GenTest,
+		Tokens:    []string{strings.ToLower(in.ident.Name)},
+		Context:   context.Elements(),
+	})
+	switch {
+	case err != nil:
+		in.expandErr = err.Error()
+	case results[0].Error != "":
+		in.expandErr = results[0].Error
+	case len(results[0].Expansions) > 0:
+		in.expanded = results[0].Expansions
+		in.candidates[in.ident.Name] = []string{strings.Join(results[0].Expansions, " ")}
+	}
+}
+
+// rename writes the expanded soft words using the case style of the identifier. It returns an empty
+// string if the identifier has no expansion.
+func (in *insight) rename() string {
+	if len(in.expanded) == 0 {
+		return ""
+	}
+
+	words := split.FromWords(strings.Join(in.expanded, ""), in.expanded, "")
+	style := casing.Detect(in.ident.Name)
+	if style == casing.Mixed {
+		style = casing.Snake
+	}
+	if ast.IsExported(in.ident.Name) && style == casing.Camel {
+		style = casing.Pascal
+	}
+
+	renamed := casing.Render(words, style)
+	if renamed == in.ident.Name {
+		return ""
+	}
+
+	return renamed
+}
+
+// occurrences retrieves the identifiers on the document referring to the same object as the inspected
+// identifier. It returns nil when the object can't be resolved on the document, as for selectors,
+// struct fields, methods and package-qualified names, since renaming every identifier with the same
+// name would break the code.
+func (in *insight) occurrences() []*ast.Ident {
+	if in.ident.Obj == nil || in.qualified || in.isField() {
+		return nil
+	}
+
+	var idents []*ast.Ident
+	ast.Inspect(in.file, func(node ast.Node) bool {
+		if id, ok := node.(*ast.Ident); ok && id.Name == in.ident.Name && id.Obj == in.ident.Obj {
+			idents = append(idents, id)
+		}
+		return true
+	})
+
+	return idents
+}
+
+// isField checks if the identifier is declared as a struct field or an interface method. The parser
+// resolves the declaration, but not its uses on selectors, such as s.buf.
+func (in *insight) isField() bool {
+	field, ok := in.ident.Obj.Decl.(*ast.Field)
+	if !ok {
+		return false
+	}
+
+	var found bool
+	ast.Inspect(in.file, func(node ast.Node) bool {
+		var fields *ast.FieldList
+		switch n := node.(type) {
+		case *ast.StructType:
+			fields = n.Fields
+		case *ast.InterfaceType:
+			fields = n.Methods
+		}
+		if fields != nil {
+			for _, f := range fields.List {
+				if f == field {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+
+	return found
+}
+
+// markdown describes the split and the expansion of the identifier.
+func (in *insight) markdown(splitter string, expander string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s**\n\n", in.ident.Name)
+
+	if in.splitErr != "" {
+		fmt.Fprintf(&b, "split (%s): %s\n\n", splitter, in.splitErr)
+	} else {
+		fmt.Fprintf(&b, "split (%s): `%s`\n\n", splitter, in.parts.Join("` `"))
+	}
+
+	switch {
+	case in.expandErr != "":
+		fmt.Fprintf(&b, "expansion (%s): %s\n", expander, in.expandErr)
+	case len(in.candidates) == 0:
+		fmt.Fprintf(&b, "expansion (%s): no expansions found\n", expander)
+	default:
+		fmt.Fprintf(&b, "expansion (%s): %s\n", expander, strings.Join(in.expanded, " "))
+		listed := make(map[string]bool)
+		for _, word := range append(in.parts.Words(), in.ident.Name) {
+			if candidates, ok := in.candidates[word]; ok && !listed[word] {
+				fmt.Fprintf(&b, "* `%s`: %s\n", word, strings.Join(candidates, ", "))
+				listed[word] = true
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// commentsOf retrieves the comments found on the file, used as the reference text for AMAP.
+func commentsOf(file *ast.File) []string {
+	comments := make([]string, 0, len(file.Comments))
+	for _, group := range file.Comments {
+		comments = append(comments, group.Text())
+	}
+
+	return comments
+}
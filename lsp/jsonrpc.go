@@ -0,0 +1,97 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/textproto"
+	"strconv"
+	"strings"
+)
+
+// JSON-RPC error codes used by the server.
+const (
+	codeParseError     = -32700
+	codeInvalidParams  = -32602
+	codeMethodNotFound = -32601
+	codeInvalidRequest = -32600
+)
+
+// maxMessageSize is the maximum size of the content of a message, in bytes.
+const maxMessageSize = 16 << 20
+
+// errMissingLength indicates that a message header has no Content-Length.
+var errMissingLength = errors.New("missing Content-Length header")
+
+// errMessageTooLarge indicates that the Content-Length of a message exceeds maxMessageSize.
+var errMessageTooLarge = errors.New("message too large")
+
+// request is a JSON-RPC request or notification, which has no ID.
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// isNotification indicates that the request expects no response.
+func (r request) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+// response is a JSON-RPC response, holding either a result or an error.
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *responseError  `json:"error,omitempty"`
+}
+
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// readMessage reads the content of a message, preceded by its headers as defined by the base protocol.
+// Messages larger than maxMessageSize are rejected before reading their content.
+func readMessage(r *bufio.Reader) ([]byte, error) {
+	headers, err := textproto.NewReader(r).ReadMIMEHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	value := headers.Get("Content-Length")
+	if value == "" {
+		return nil, errMissingLength
+	}
+	length, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || length < 0 {
+		return nil, fmt.Errorf("invalid Content-Length header %q", value)
+	}
+	if length > maxMessageSize {
+		return nil, fmt.Errorf("%w: %d bytes, up to %d bytes are allowed", errMessageTooLarge, length, maxMessageSize)
+	}
+
+	content := make([]byte, length)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// writeMessage writes the JSON encoding of v, preceded by its Content-Length header.
+func writeMessage(w io.Writer, v interface{}) error {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Content-Length: %d\r\n\r\n", len(content)); err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
@@ -0,0 +1,232 @@
+// Package lsp provides a Language Server Protocol server that gives insight on the identifiers of Go
+// documents: hovering an identifier shows its split and its expansion, and a code action renames an
+// abbreviated identifier to its expanded form.
+//
+// The token scope used by AMAP and the context used by GenTest are built from the open document,
+// so the server needs no other sources of information.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sync"
+
+	"github.com/eroatta/token/service"
+)
+
+// Server handles the requests sent by a language client, using a service to split and expand the
+// identifiers.
+type Server struct {
+	svc      *service.Service
+	splitter string
+	expander string
+
+	mu        sync.Mutex
+	documents map[string]string
+	shutdown  bool
+}
+
+// Option sets a setting for the server.
+type Option func(*Server)
+
+// WithSplitter sets the algorithm used to split identifiers: conserv, greedy (the default) or samurai.
+func WithSplitter(algorithm string) Option {
+	return func(s *Server) {
+		s.splitter = algorithm
+	}
+}
+
+// WithExpander sets the algorithm used to expand identifiers: amap (the default) or gentest.
+func WithExpander(algorithm string) Option {
+	return func(s *Server) {
+		s.expander = algorithm
+	}
+}
+
+// NewServer creates a server that splits and expands the identifiers using the given service.
+func NewServer(svc *service.Service, options ...Option) *Server {
+	s := &Server{
+		svc:       svc,
+		splitter:  service.Greedy,
+		expander:  service.AMAP,
+		documents: make(map[string]string),
+	}
+	for _, option := range options {
+		option(s)
+	}
+
+	return s
+}
+
+// Serve reads the messages sent by the client from r and writes the responses to w, until the client
+// sends the exit notification or closes the connection.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	reader := bufio.NewReader(r)
+	for {
+		content, err := readMessage(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var req request
+		if err := json.Unmarshal(content, &req); err != nil {
+			if err := writeMessage(w, errorResponse(nil, codeParseError, err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if req.Method == "exit" {
+			return nil
+		}
+
+		result, rpcErr := s.handle(req)
+		if req.isNotification() {
+			continue
+		}
+
+		resp := response{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}
+		if rpcErr == nil {
+			if resp.Result, err = json.Marshal(result); err != nil {
+				return err
+			}
+		}
+		if err := writeMessage(w, resp); err != nil {
+			return err
+		}
+	}
+}
+
+// handle runs the method of the request, returning its result or its error.
+func (s *Server) handle(req request) (interface{}, *responseError) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.shutdown && !req.isNotification() {
+		return nil, &responseError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	}
+
+	switch req.Method {
+	case "initialize":
+		return initializeResult{
+			Capabilities: serverCapabilities{TextDocumentSync: 1, HoverProvider: true, CodeActionProvider: true},
+			ServerInfo:   serverInfo{Name: "tokenls"},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(req.Params, &params); err == nil {
+			s.documents[params.TextDocument.URI] = params.TextDocument.Text
+		}
+		return nil, nil
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(req.Params, &params); err == nil && len(params.ContentChanges) > 0 {
+			s.documents[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(req.Params, &params); err == nil {
+			delete(s.documents, params.TextDocument.URI)
+		}
+		return nil, nil
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return s.hover(params), nil
+	case "textDocument/codeAction":
+		var params codeActionParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		return s.codeActions(params), nil
+	}
+
+	if req.isNotification() {
+		return nil, nil
+	}
+	return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+}
+
+// hover describes the identifier found at the position, or returns nil if there's none.
+func (s *Server) hover(params textDocumentPositionParams) *hover {
+	text, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil
+	}
+
+	in := s.inspect(filename(params.TextDocument.URI), text, offsetOf(text, params.Position))
+	if in == nil {
+		return nil
+	}
+
+	return &hover{
+		Contents: markupContent{Kind: "markdown", Value: in.markdown(s.splitter, s.expander)},
+		Range:    textRange{Start: positionOf(text, in.start), End: positionOf(text, in.end)},
+	}
+}
+
+// codeActions offers to rename the identifier found at the start of the range to its expanded form,
+// replacing its occurrences on the document. No rename is offered when the identifier can't be resolved
+// on the document.
+func (s *Server) codeActions(params codeActionParams) []codeAction {
+	actions := []codeAction{}
+
+	uri := params.TextDocument.URI
+	text, ok := s.documents[uri]
+	if !ok {
+		return actions
+	}
+
+	in := s.inspect(filename(uri), text, offsetOf(text, params.Range.Start))
+	if in == nil {
+		return actions
+	}
+
+	occurrences := in.occurrences()
+	renamed := in.rename()
+	if renamed == "" || len(occurrences) == 0 {
+		return actions
+	}
+
+	edits := make([]textEdit, 0, len(occurrences))
+	for _, ident := range occurrences {
+		start := in.start + int(ident.Pos()-in.ident.Pos())
+		edits = append(edits, textEdit{
+			Range:   textRange{Start: positionOf(text, start), End: positionOf(text, start+len(ident.Name))},
+			NewText: renamed,
+		})
+	}
+
+	return append(actions, codeAction{
+		Title:       fmt.Sprintf("Rename abbreviated identifier %q to %q", in.ident.Name, renamed),
+		Kind:        "refactor.rewrite",
+		Edit:        workspaceEdit{Changes: map[string][]textEdit{uri: edits}},
+		IsPreferred: true,
+	})
+}
+
+// filename retrieves the file name from a document URI, used when reporting parsing errors.
+func filename(uri string) string {
+	if u, err := url.Parse(uri); err == nil && u.Path != "" {
+		return path.Base(u.Path)
+	}
+
+	return uri
+}
+
+func errorResponse(id json.RawMessage, code int, message string) response {
+	return response{JSONRPC: "2.0", ID: id, Error: &responseError{Code: code, Message: message}}
+}
@@ -0,0 +1,253 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/gentest"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/service"
+	"github.com/stretchr/testify/assert"
+)
+
+const documentURI = "file:///tmp/sample/handler.go"
+
+const document = `package sample
+
+// handle writes the HTTP response.
+func handle(w io.Writer, httpResp *Response) error {
+	buf := new(bytes.Buffer)
+	buf.WriteString("response")
+	return write(w, buf, httpResp)
+}
+`
+
+func newTestServer(options ...Option) *Server {
+	similarity := gentest.NewSimilarityTable()
+	similarity.Set("http", "response", 0.9)
+
+	svc := service.New(
+		service.WithGreedyList(lists.NewBuilder().Add("http", "resp", "buf", "buffer", "handle").Build()),
+		service.WithExpansions(expansion.NewSetBuilder().AddStrings("http", "response", "buffer").Build()),
+		service.WithSimilarity(similarity),
+	)
+
+	return NewServer(svc, options...)
+}
+
+// exchange sends the messages to the server, as a client would, and returns the responses.
+func exchange(t *testing.T, s *Server, messages ...interface{}) []response {
+	var in bytes.Buffer
+	for _, message := range messages {
+		assert.NoError(t, writeMessage(&in, message))
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, s.Serve(&in, &out))
+
+	var responses []response
+	reader := bufio.NewReader(&out)
+	for {
+		content, err := readMessage(reader)
+		if err != nil {
+			break
+		}
+		var resp response
+		assert.NoError(t, json.Unmarshal(content, &resp))
+		responses = append(responses, resp)
+	}
+
+	return responses
+}
+
+func call(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+func notify(method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+func openDocument(text string) map[string]interface{} {
+	return notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": documentURI, "languageId": "go", "version": 1, "text": text},
+	})
+}
+
+func at(text string, substr string) position {
+	return positionOf(text, strings.Index(text, substr))
+}
+
+func TestServe_OnInitialize_ShouldAdvertiseCapabilities(t *testing.T) {
+	responses := exchange(t, newTestServer(), call(1, "initialize", map[string]interface{}{}), notify("initialized", nil),
+		call(2, "shutdown", nil), notify("exit", nil))
+
+	assert.Equal(t, 2, len(responses))
+	var result initializeResult
+	assert.NoError(t, json.Unmarshal(responses[0].Result, &result))
+	assert.Equal(t, serverCapabilities{TextDocumentSync: 1, HoverProvider: true, CodeActionProvider: true}, result.Capabilities)
+	assert.Equal(t, "null", string(responses[1].Result))
+	assert.Nil(t, responses[1].Error)
+}
+
+func TestServe_OnUnknownMethod_ShouldReturnMethodNotFound(t *testing.T) {
+	responses := exchange(t, newTestServer(), call(1, "workspace/symbol", map[string]interface{}{}))
+
+	assert.Equal(t, 1, len(responses))
+	assert.Equal(t, codeMethodNotFound, responses[0].Error.Code)
+}
+
+func TestServe_OnLargeContentLength_ShouldReturnError(t *testing.T) {
+	in := strings.NewReader("Content-Length: 1099511627776\r\n\r\n{}")
+
+	err := newTestServer().Serve(in, &bytes.Buffer{})
+
+	assert.True(t, errors.Is(err, errMessageTooLarge))
+}
+
+func TestHover_OnAbbreviatedIdentifier_ShouldShowSplitAndExpansion(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Option
+		position position
+		expected []string
+	}{
+		{"amap_declaration", nil, at(document, "buf.WriteString"), []string{
+			"**buf**", "split (greedy): `buf`", "expansion (amap): buffer", "* `buf`: buffer",
+		}},
+		{"amap_parameter", nil, at(document, "httpResp *"), []string{
+			"**httpResp**", "split (greedy): `http` `resp`", "expansion (amap): http response", "* `resp`: response",
+		}},
+		{"gentest", []Option{WithSplitter("conserv"), WithExpander("gentest")}, at(document, "httpResp)"), []string{
+			"**httpResp**", "split (conserv): `http` `resp`", "expansion (gentest): http response",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := exchange(t, newTestServer(tt.options...), openDocument(document),
+				call(1, "textDocument/hover", map[string]interface{}{
+					"textDocument": map[string]interface{}{"uri": documentURI},
+					"position":     tt.position,
+				}))
+
+			assert.Equal(t, 1, len(responses))
+			var got hover
+			assert.NoError(t, json.Unmarshal(responses[0].Result, &got))
+			assert.Equal(t, "markdown", got.Contents.Kind)
+			for _, line := range tt.expected {
+				assert.Contains(t, got.Contents.Value, line)
+			}
+			assert.Equal(t, tt.position.Line, got.Range.Start.Line)
+		})
+	}
+}
+
+func TestHover_OnPositionWithoutIdentifier_ShouldReturnNull(t *testing.T) {
+	responses := exchange(t, newTestServer(), openDocument(document),
+		call(1, "textDocument/hover", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": documentURI},
+			"position":     at(document, "// handle"),
+		}))
+
+	assert.Equal(t, 1, len(responses))
+	assert.Equal(t, "null", string(responses[0].Result))
+}
+
+func TestCodeAction_OnAbbreviatedIdentifier_ShouldRenameEveryOccurrence(t *testing.T) {
+	responses := exchange(t, newTestServer(), openDocument(document),
+		call(1, "textDocument/codeAction", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": documentURI},
+			"range":        textRange{Start: at(document, "buf :="), End: at(document, " :=")},
+			"context":      map[string]interface{}{"diagnostics": []interface{}{}},
+		}))
+
+	assert.Equal(t, 1, len(responses))
+	var actions []codeAction
+	assert.NoError(t, json.Unmarshal(responses[0].Result, &actions))
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, `Rename abbreviated identifier "buf" to "buffer"`, actions[0].Title)
+
+	edits := actions[0].Edit.Changes[documentURI]
+	assert.Equal(t, 3, len(edits))
+	for _, edit := range edits {
+		assert.Equal(t, "buffer", edit.NewText)
+		assert.Equal(t, 3, edit.Range.End.Character-edit.Range.Start.Character)
+	}
+}
+
+func TestCodeAction_OnChangedDocument_ShouldUseTheLatestText(t *testing.T) {
+	changed := strings.Replace(document, "buf", "buffer", -1)
+
+	responses := exchange(t, newTestServer(), openDocument(document),
+		notify("textDocument/didChange", map[string]interface{}{
+			"textDocument":   map[string]interface{}{"uri": documentURI, "version": 2},
+			"contentChanges": []interface{}{map[string]interface{}{"text": changed}},
+		}),
+		call(1, "textDocument/codeAction", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": documentURI},
+			"range":        textRange{Start: at(changed, "buffer :="), End: at(changed, "buffer :=")},
+		}))
+
+	assert.Equal(t, 1, len(responses))
+	assert.Equal(t, "[]", string(responses[0].Result))
+}
+
+func TestCodeAction_OnUnresolvedIdentifiers_ShouldNotRename(t *testing.T) {
+	text := `package sample
+
+// handle writes the buffer.
+func handle(cache *Cache) {
+	buffer := new(bytes.Buffer)
+	buffer.WriteString(conf.buf)
+	cache.buf = buffer
+}
+
+// writer writes the buffer.
+type writer struct {
+	buf []byte
+}
+
+func (w *writer) write() {
+	w.buf = append(w.buf, "buffer"...)
+}
+`
+	tests := []struct {
+		name   string
+		substr string
+	}{
+		{"package_qualified_selector", "buf)"},
+		{"field_selector", "buf ="},
+		{"struct_field", "buf []byte"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := exchange(t, newTestServer(), openDocument(text),
+				call(1, "textDocument/codeAction", map[string]interface{}{
+					"textDocument": map[string]interface{}{"uri": documentURI},
+					"range":        textRange{Start: at(text, tt.substr), End: at(text, tt.substr)},
+				}))
+
+			assert.Equal(t, 1, len(responses))
+			assert.Equal(t, "[]", string(responses[0].Result))
+		})
+	}
+}
+
+func TestOffsetOf_OnPosition_ShouldCountUTF16Units(t *testing.T) {
+	text := "a := \"é😀\"\nbuf"
+
+	assert.Equal(t, 0, offsetOf(text, position{Line: 0, Character: 0}))
+	assert.Equal(t, 8, offsetOf(text, position{Line: 0, Character: 7}))
+	assert.Equal(t, 13, offsetOf(text, position{Line: 0, Character: 100}))
+	assert.Equal(t, 15, offsetOf(text, position{Line: 1, Character: 1}))
+	assert.Equal(t, len(text), offsetOf(text, position{Line: 5, Character: 0}))
+	assert.Equal(t, position{Line: 0, Character: 7}, positionOf(text, 8))
+	assert.Equal(t, position{Line: 1, Character: 1}, positionOf(text, 15))
+}
@@ -0,0 +1,205 @@
+package marker
+
+import (
+	"strings"
+	"unicode"
+)
+
+// techAcronyms is a list of common acronyms found on identifiers, written on their canonical case.
+var techAcronyms = []string{
+	"ACL", "AES", "AMQP", "API", "ARN", "ASCII", "AST", "AWS", "BOM", "CA", "CDN", "CLI", "CORS", "CPU", "CRC",
+	"CRLF", "CRUD", "CSRF", "CSS", "CSV", "CTX", "DAO", "DB", "DNS", "DOM", "DSN", "DTO", "EOF", "FIFO", "FTP",
+	"GC", "GID", "GPS", "GPU", "GUI", "GUID", "HMAC", "HTML", "HTTP", "HTTPS", "ID", "IO", "IP", "JSON", "JWT",
+	"LDAP", "LHS", "LIFO", "MD5", "MIME", "MQTT", "NAT", "NFS", "OID", "OS", "PDF", "PEM", "PID", "QPS", "RAM",
+	"RGB", "RHS", "RPC", "RSA", "SDK", "SHA1", "SHA256", "SHA512", "SLA", "SMTP", "SQL", "SSH", "SSL", "SSO",
+	"TCP", "TLS", "TTL", "UDP", "UI", "UID", "URI", "URL", "UTC", "UTF8", "UTF16", "UUID", "VM", "X509", "XML",
+	"XSRF", "XSS", "YAML",
+	"eBPF", "gRPC", "GraphQL", "iOS", "IPv4", "IPv6", "macOS", "MySQL", "NoSQL", "OAuth", "OAuth2", "WiFi",
+}
+
+// KnownAcronyms contains the acronyms protected by the marker functions, seeded from a list of common
+// technical acronyms. The known abbreviations list isn't used, as it holds English contractions and
+// words such as "its" or "well", which would be written in upper case (i.e. "ITSValue").
+var KnownAcronyms = NewAcronyms(techAcronyms...)
+
+// Acronyms holds a set of known acronyms.
+//
+// An acronym matches the upper case form of the word (i.e. "URL" or "HTTP"), or its exact form when it's
+// written using mixed case (i.e. "iOS" or "OAuth"), optionally followed by a plural "s" (i.e. "URLs" or "IDs").
+type Acronyms struct {
+	canonical map[string]string
+	longest   int
+}
+
+// NewAcronyms creates a set of acronyms with the given words, written on their canonical case.
+func NewAcronyms(acronyms ...string) Acronyms {
+	a := Acronyms{canonical: make(map[string]string, len(acronyms))}
+	for _, acronym := range acronyms {
+		if len(acronym) < 2 {
+			continue
+		}
+
+		// lower case words, as the ones from lists, are written on upper case
+		canonical := acronym
+		if strings.ToLower(acronym) == acronym {
+			canonical = strings.ToUpper(acronym)
+		}
+
+		a.canonical[strings.ToLower(acronym)] = canonical
+		if len(acronym) > a.longest {
+			a.longest = len(acronym)
+		}
+	}
+
+	return a
+}
+
+// Canonical returns the canonical form for the given word (i.e. "HTTP" for "http"), and whether
+// the word is a known acronym.
+func (a Acronyms) Canonical(word string) (string, bool) {
+	canonical, ok := a.canonical[strings.ToLower(word)]
+	return canonical, ok
+}
+
+// matches checks if the candidate is written as the acronym.
+func (a Acronyms) matches(candidate string) bool {
+	canonical, ok := a.canonical[strings.ToLower(candidate)]
+	if !ok {
+		return false
+	}
+
+	return candidate == canonical || candidate == strings.ToUpper(canonical)
+}
+
+// OnAcronyms applies markers around each known acronym found on the token.
+func OnAcronyms(token string, acronyms Acronyms) string {
+	segments, _ := splitMatches(token, acronyms.end)
+	return joinSegments(segments)
+}
+
+// Protect applies the given marker functions on the token, keeping the known acronyms
+// together, so they are not split by any of the functions. Markers are also applied around
+// each acronym.
+func Protect(token string, acronyms Acronyms, markers ...func(string) string) string {
+	segments, isAcronym := splitMatches(token, acronyms.end)
+	for i := range segments {
+		if isAcronym[i] {
+			continue
+		}
+
+		for _, mark := range markers {
+			segments[i] = mark(segments[i])
+		}
+	}
+
+	return joinSegments(segments)
+}
+
+// splitMatches splits the token on segments, isolating the words found by the given function, which
+// returns the position where a word starting at the given position ends, or -1 if there's no word.
+func splitMatches(token string, wordEnd func([]rune, int) int) ([]string, []bool) {
+	runes := []rune(token)
+
+	segments := make([]string, 0)
+	isMatch := make([]bool, 0)
+	start := 0
+	for i := 0; i < len(runes); {
+		if !isWordStart(runes, i, start) {
+			i++
+			continue
+		}
+
+		end := wordEnd(runes, i)
+		if end < 0 {
+			i++
+			continue
+		}
+
+		if start < i {
+			segments = append(segments, string(runes[start:i]))
+			isMatch = append(isMatch, false)
+		}
+		segments = append(segments, string(runes[i:end]))
+		isMatch = append(isMatch, true)
+
+		start = end
+		i = end
+	}
+
+	if start < len(runes) || len(segments) == 0 {
+		segments = append(segments, string(runes[start:]))
+		isMatch = append(isMatch, false)
+	}
+
+	return segments, isMatch
+}
+
+// isWordStart checks if a word can start at the given position: at the beginning of the token,
+// after a previous word, a separator or a digit, or on a lower to upper case transition.
+func isWordStart(runes []rune, i int, lastEnd int) bool {
+	if i == 0 || i == lastEnd {
+		return true
+	}
+
+	prev := runes[i-1]
+	if !unicode.IsLetter(prev) {
+		return true
+	}
+
+	return unicode.IsLower(prev) && unicode.IsUpper(runes[i])
+}
+
+// end looks for the longest acronym starting at the given position, and returns the position
+// where it ends, or -1 if there's no acronym.
+func (acronyms Acronyms) end(runes []rune, i int) int {
+	max := i + acronyms.longest
+	if max > len(runes) {
+		max = len(runes)
+	}
+
+	for end := max; end >= i+2; end-- {
+		candidate := string(runes[i:end])
+		if !acronyms.matches(candidate) {
+			continue
+		}
+
+		// an acronym can be followed by a plural "s"
+		if end < len(runes) && runes[end] == 's' && isAcronymBoundary(runes, end+1) {
+			return end + 1
+		}
+
+		if isAcronymBoundary(runes, end) {
+			return end
+		}
+	}
+
+	return -1
+}
+
+// isAcronymBoundary checks if an acronym can end before the given position: at the end of the token,
+// before a separator or a digit, or before a capitalized word.
+func isAcronymBoundary(runes []rune, end int) bool {
+	if end == len(runes) {
+		return true
+	}
+
+	next := runes[end]
+	if !unicode.IsLetter(next) {
+		return true
+	}
+
+	return unicode.IsUpper(next) && end+1 < len(runes) && unicode.IsLower(runes[end+1])
+}
+
+// joinSegments joins the segments using markers, avoiding repeated markers.
+func joinSegments(segments []string) string {
+	var builder strings.Builder
+	for i, segment := range segments {
+		if i > 0 && !strings.HasSuffix(segments[i-1], "_") && !strings.HasPrefix(segment, "_") {
+			builder.WriteString("_")
+		}
+		builder.WriteString(segment)
+	}
+
+	return builder.String()
+}
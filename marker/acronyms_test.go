@@ -0,0 +1,85 @@
+package marker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnAcronyms_ShouldAddMarkersAroundKnownAcronyms(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"no_acronyms", "squarePants", "squarePants"},
+		{"upper_case_acronym", "HTTPServer", "HTTP_Server"},
+		{"plural_acronym", "parseURLs", "parse_URLs"},
+		{"plural_acronym_before_word", "userIDsList", "user_IDs_List"},
+		{"mixed_case_acronym", "iOSVersion", "iOS_Version"},
+		{"mixed_case_acronym_after_word", "newOAuthToken", "new_OAuth_Token"},
+		{"digit_bearing_acronym", "MD5Hash", "MD5_Hash"},
+		{"longest_acronym", "UTF16Decoder", "UTF16_Decoder"},
+		{"acronym_after_marker", "get_URL", "get_URL"},
+		{"acronym_as_prefix_of_upper_case_word", "IDENTITYValue", "IDENTITYValue"},
+		{"acronym_as_prefix_of_lower_case_word", "URLencode", "URLencode"},
+		{"lower_case_acronym", "parseurl", "parseurl"},
+		{"title_case_acronym", "parseUrl", "parseUrl"},
+		{"upper_case_english_word", "ITSValue", "ITSValue"},
+		{"empty_token", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := OnAcronyms(tt.token, KnownAcronyms)
+
+			assert.Equal(t, tt.want, got, fmt.Sprintf("got: %v", got))
+		})
+	}
+}
+
+func TestCanonical_OnKnownAbbreviations_ShouldNotReturnAcronyms(t *testing.T) {
+	for _, word := range []string{"shell", "well", "its", "dont"} {
+		_, ok := KnownAcronyms.Canonical(word)
+
+		assert.False(t, ok, word)
+	}
+}
+
+func TestProtect_ShouldNotApplyMarkersOnKnownAcronyms(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"plural_acronym", "parseURLs", "parse_URLs"},
+		{"mixed_case_acronym", "iOSVersion2", "iOS_Version_2"},
+		{"digit_bearing_acronym", "UTF8Decoder", "UTF8_Decoder"},
+		{"unknown_acronym", "ABCDecoder", "ABC_Decoder"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Protect(tt.token, KnownAcronyms, OnDigits, OnLowerToUpperCase, OnUpperToLowerCase)
+
+			assert.Equal(t, tt.want, got, fmt.Sprintf("got: %v", got))
+		})
+	}
+}
+
+func TestNewAcronyms_ShouldMatchCanonicalAndUpperCaseForms(t *testing.T) {
+	acronyms := NewAcronyms("cfg", "GraphQL", "x")
+
+	assert.Equal(t, "CFG_File", OnAcronyms("CFGFile", acronyms))
+	assert.Equal(t, "GraphQL_Schema", OnAcronyms("GraphQLSchema", acronyms))
+	assert.Equal(t, "GRAPHQL_Schema", OnAcronyms("GRAPHQLSchema", acronyms))
+	assert.Equal(t, "XFile", OnAcronyms("XFile", acronyms), "single letters are not acronyms")
+
+	canonical, ok := acronyms.Canonical("graphql")
+	assert.True(t, ok)
+	assert.Equal(t, "GraphQL", canonical)
+
+	_, ok = acronyms.Canonical("x")
+	assert.False(t, ok)
+}
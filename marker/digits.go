@@ -0,0 +1,115 @@
+package marker
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/eroatta/token/lists"
+)
+
+// techDigitWords is a list of common technical terms that include digits.
+var techDigitWords = []string{
+	"2fa", "3d", "b2b", "b2c", "base32", "base58", "base64", "crc16", "crc32", "crc64", "ec2", "h264", "h265",
+	"http2", "http3", "i18n", "int128", "ipv4", "ipv6", "k8s", "l10n", "md4", "md5", "mp3", "mp4", "oauth1",
+	"oauth2", "p2p", "pkcs1", "pkcs7", "pkcs8", "pkcs12", "s3", "sha1", "sha224", "sha256", "sha384",
+	"sha512", "uint128", "utf7", "utf8", "utf16", "utf32", "v1", "v2", "v3", "win32", "win64", "x11", "x509",
+	"x86", "x64",
+}
+
+// DigitWords contains the words that include digits and must be kept together by the GlueDigits policy.
+// It includes the stop list entries that include digits (i.e. "int64" or "float32"), along with a list of
+// common technical terms (i.e. "md5", "utf8" or "oauth2").
+var DigitWords = lists.Union(
+	lists.Filter(lists.Stop, func(word string) bool { return strings.IndexFunc(word, unicode.IsDigit) >= 0 }),
+	lists.NewBuilder().Add(techDigitWords...).Build(),
+)
+
+// DigitPolicy applies markers between letters and digits.
+type DigitPolicy func(token string) string
+
+var (
+	// SplitDigits always applies markers between letters and digits.
+	SplitDigits DigitPolicy = OnDigits
+
+	// KeepDigits never applies markers between letters and digits.
+	KeepDigits DigitPolicy = func(token string) string {
+		return token
+	}
+)
+
+// GlueDigits creates a policy that applies markers between letters and digits, except when the
+// letters and digits combined form a word on the given list (i.e. "int64" or "utf8").
+func GlueDigits(words lists.List) DigitPolicy {
+	longest := 0
+	for _, w := range words.Elements() {
+		if len(w) > longest {
+			longest = len(w)
+		}
+	}
+
+	wordEnd := func(runes []rune, i int) int {
+		return digitWordEnd(runes, i, words, longest)
+	}
+
+	return func(token string) string {
+		segments, isWord := splitMatches(token, wordEnd)
+		for i := range segments {
+			if !isWord[i] {
+				segments[i] = OnDigits(segments[i])
+			}
+		}
+
+		return joinSegments(segments)
+	}
+}
+
+// digitWordEnd looks for the longest word with digits on the list starting at the given position, and
+// returns the position where it ends, or -1 if there's no word.
+func digitWordEnd(runes []rune, i int, words lists.List, longest int) int {
+	max := i + longest
+	if max > len(runes) {
+		max = len(runes)
+	}
+
+	for end := max; end >= i+2; end-- {
+		candidate := runes[i:end]
+		if !hasLettersAndDigits(candidate) || !words.Contains(string(candidate)) {
+			continue
+		}
+
+		if isDigitWordBoundary(runes, end) {
+			return end
+		}
+	}
+
+	return -1
+}
+
+// isDigitWordBoundary checks if a word with digits can end before the given position: at the end of
+// the token, before a separator or an upper case letter, or before any letter if the word ends with a digit.
+func isDigitWordBoundary(runes []rune, end int) bool {
+	if end == len(runes) {
+		return true
+	}
+
+	next := runes[end]
+	if !unicode.IsLetter(next) && !unicode.IsDigit(next) {
+		return true
+	}
+
+	if unicode.IsUpper(next) {
+		return true
+	}
+
+	return unicode.IsLetter(next) && unicode.IsDigit(runes[end-1])
+}
+
+func hasLettersAndDigits(runes []rune) bool {
+	var letters, digits bool
+	for _, r := range runes {
+		letters = letters || unicode.IsLetter(r)
+		digits = digits || unicode.IsDigit(r)
+	}
+
+	return letters && digits
+}
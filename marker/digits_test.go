@@ -0,0 +1,45 @@
+package marker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigitPolicies_ShouldApplyMarkersBetweenLettersAndDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		policy DigitPolicy
+		want   string
+	}{
+		{"split_digits", "parseInt64Value", SplitDigits, "parseInt_64_Value"},
+		{"keep_digits", "parseInt64Value", KeepDigits, "parseInt64Value"},
+		{"glue_stop_list_word", "parseInt64Value", GlueDigits(DigitWords), "parse_Int64_Value"},
+		{"glue_word_at_start", "md5sum", GlueDigits(DigitWords), "md5_sum"},
+		{"glue_word_at_end", "toFloat32", GlueDigits(DigitWords), "to_Float32"},
+		{"glue_several_words", "utf8ToBase64", GlueDigits(DigitWords), "utf8_To_Base64"},
+		{"glue_word_with_trailing_letters", "i18nBundle", GlueDigits(DigitWords), "i18n_Bundle"},
+		{"glue_longest_word", "sha256Sum", GlueDigits(DigitWords), "sha256_Sum"},
+		{"glue_unknown_word", "leto2nd", GlueDigits(DigitWords), "leto_2_nd"},
+		{"glue_word_followed_by_digits", "int648", GlueDigits(DigitWords), "int_648"},
+		{"glue_word_inside_lower_case_word", "getint64", GlueDigits(DigitWords), "getint_64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy(tt.token)
+
+			assert.Equal(t, tt.want, got, fmt.Sprintf("got: %v", got))
+		})
+	}
+}
+
+func TestDigitWords_ShouldIncludeStopListAndTechnicalTerms(t *testing.T) {
+	for _, word := range []string{"int64", "float32", "md5", "utf8", "x509", "base64", "ipv6", "oauth2"} {
+		assert.True(t, DigitWords.Contains(word), word)
+	}
+
+	assert.False(t, DigitWords.Contains("int"))
+}
@@ -0,0 +1,170 @@
+package miner
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/eroatta/token/expansion"
+)
+
+// Location is a position on the source code where a short form is defined.
+type Location struct {
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// Entry relates a short form with one of its long forms.
+type Entry struct {
+	ShortForm string     `json:"short_form"`
+	LongForm  string     `json:"long_form"`
+	Count     int        `json:"count"`
+	Locations []Location `json:"locations"`
+}
+
+// Dictionary holds the abbreviations mined from the source code.
+type Dictionary struct {
+	entries map[string]map[string]*Entry
+}
+
+// NewDictionary creates and initializes an empty dictionary.
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		entries: make(map[string]map[string]*Entry),
+	}
+}
+
+// Add registers the definition of a short form with the given long form, found on the given location.
+// Both short and long forms are stored in lower case.
+func (d *Dictionary) Add(shortForm string, longForm string, location Location) {
+	shortForm = strings.ToLower(shortForm)
+	longForm = strings.ToLower(longForm)
+
+	longForms, ok := d.entries[shortForm]
+	if !ok {
+		longForms = make(map[string]*Entry)
+		d.entries[shortForm] = longForms
+	}
+
+	entry, ok := longForms[longForm]
+	if !ok {
+		entry = &Entry{ShortForm: shortForm, LongForm: longForm}
+		longForms[longForm] = entry
+	}
+	entry.Count++
+	entry.Locations = append(entry.Locations, location)
+}
+
+// Lookup retrieves the entries for a short form, sorted by decreasing count.
+func (d *Dictionary) Lookup(shortForm string) []Entry {
+	longForms := d.entries[strings.ToLower(shortForm)]
+
+	entries := make([]Entry, 0, len(longForms))
+	for _, entry := range longForms {
+		entries = append(entries, *entry)
+	}
+	sortEntries(entries)
+
+	return entries
+}
+
+// Entries retrieves every entry on the dictionary, sorted by short form and decreasing count.
+func (d *Dictionary) Entries() []Entry {
+	entries := make([]Entry, 0, len(d.entries))
+	for _, longForms := range d.entries {
+		for _, entry := range longForms {
+			entries = append(entries, *entry)
+		}
+	}
+	sortEntries(entries)
+
+	return entries
+}
+
+// Phrases builds a phrases list, as the one used by the Basic expander, relating each short form with its
+// most frequent long form, with its words joined by hyphens (i.e. "json": "javascript-object-notation").
+func (d *Dictionary) Phrases() map[string]string {
+	phrases := make(map[string]string, len(d.entries))
+	for shortForm := range d.entries {
+		best := d.Lookup(shortForm)[0]
+		phrases[shortForm] = strings.ReplaceAll(best.LongForm, " ", "-")
+	}
+
+	return phrases
+}
+
+// ReferenceText builds a reference text, as the one used by the AMAP expander, holding each long form
+// as many times as it was found.
+func (d *Dictionary) ReferenceText() []string {
+	text := make([]string, 0)
+	for _, entry := range d.Entries() {
+		for i := 0; i < entry.Count; i++ {
+			text = append(text, entry.LongForm)
+		}
+	}
+
+	return text
+}
+
+// Expansions builds a set of possible expansions, as the one used by the GenTest and Basic expanders,
+// holding the words of every long form.
+func (d *Dictionary) Expansions() expansion.Set {
+	builder := expansion.NewSetBuilder()
+	for _, entry := range d.Entries() {
+		builder.AddStrings(strings.Fields(entry.LongForm)...)
+	}
+
+	return builder.Build()
+}
+
+// Save writes the dictionary as JSON.
+func (d *Dictionary) Save(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(d.Entries())
+}
+
+// Load reads a dictionary previously written by Save. As on Add, both short and long forms are stored
+// in lower case, and the entries differing only by case are merged.
+func Load(r io.Reader) (*Dictionary, error) {
+	var entries []Entry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+
+	d := NewDictionary()
+	for _, e := range entries {
+		entry := e
+		entry.ShortForm = strings.ToLower(entry.ShortForm)
+		entry.LongForm = strings.ToLower(entry.LongForm)
+
+		longForms, ok := d.entries[entry.ShortForm]
+		if !ok {
+			longForms = make(map[string]*Entry)
+			d.entries[entry.ShortForm] = longForms
+		}
+
+		if current, ok := longForms[entry.LongForm]; ok {
+			current.Count += entry.Count
+			current.Locations = append(current.Locations, entry.Locations...)
+			continue
+		}
+		longForms[entry.LongForm] = &entry
+	}
+
+	return d, nil
+}
+
+func sortEntries(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].ShortForm != entries[j].ShortForm {
+			return entries[i].ShortForm < entries[j].ShortForm
+		}
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].LongForm < entries[j].LongForm
+	})
+}
@@ -0,0 +1,92 @@
+package miner
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSampleDictionary() *Dictionary {
+	d := NewDictionary()
+	d.Add("JSON", "JavaScript Object Notation", Location{File: "a.go", Line: 1})
+	d.Add("cfg", "config", Location{File: "a.go", Line: 2})
+	d.Add("cfg", "config", Location{File: "b.go", Line: 3})
+	d.Add("cfg", "configuration", Location{File: "c.go", Line: 4})
+
+	return d
+}
+
+func TestLookup_OnDictionary_ShouldReturnEntriesByCount(t *testing.T) {
+	d := newSampleDictionary()
+
+	entries := d.Lookup("CFG")
+
+	assert.Equal(t, []Entry{
+		{ShortForm: "cfg", LongForm: "config", Count: 2, Locations: []Location{{"a.go", 2}, {"b.go", 3}}},
+		{ShortForm: "cfg", LongForm: "configuration", Count: 1, Locations: []Location{{"c.go", 4}}},
+	}, entries)
+	assert.Empty(t, d.Lookup("ctx"))
+}
+
+func TestPhrases_OnDictionary_ShouldUseMostFrequentLongForm(t *testing.T) {
+	d := newSampleDictionary()
+
+	assert.Equal(t, map[string]string{
+		"json": "javascript-object-notation",
+		"cfg":  "config",
+	}, d.Phrases())
+}
+
+func TestReferenceText_OnDictionary_ShouldRepeatLongFormsByCount(t *testing.T) {
+	d := newSampleDictionary()
+
+	assert.Equal(t, []string{"config", "config", "configuration", "javascript object notation"}, d.ReferenceText())
+}
+
+func TestExpansions_OnDictionary_ShouldContainLongFormWords(t *testing.T) {
+	d := newSampleDictionary()
+
+	expansions := d.Expansions()
+
+	for _, word := range []string{"config", "configuration", "javascript", "object", "notation"} {
+		assert.True(t, expansions.Contains(word), word)
+	}
+	assert.False(t, expansions.Contains("cfg"))
+}
+
+func TestSaveAndLoad_OnDictionary_ShouldRoundTrip(t *testing.T) {
+	d := newSampleDictionary()
+
+	var buf bytes.Buffer
+	err := d.Save(&buf)
+	assert.NoError(t, err)
+
+	loaded, err := Load(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, d.Entries(), loaded.Entries())
+}
+
+func TestLoad_OnEntriesWithUpperCase_ShouldStoreThemInLowerCase(t *testing.T) {
+	written := `[
+		{"short_form": "HTTP", "long_form": "HyperText Transfer Protocol", "count": 2, "locations": [{"file": "a.go", "line": 1}]},
+		{"short_form": "http", "long_form": "hypertext transfer protocol", "count": 1, "locations": [{"file": "b.go", "line": 2}]}
+	]`
+
+	d, err := Load(bytes.NewBufferString(written))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{{
+		ShortForm: "http",
+		LongForm:  "hypertext transfer protocol",
+		Count:     3,
+		Locations: []Location{{File: "a.go", Line: 1}, {File: "b.go", Line: 2}},
+	}}, d.Lookup("Http"))
+}
+
+func TestLoad_OnInvalidJSON_ShouldReturnError(t *testing.T) {
+	d, err := Load(bytes.NewBufferString("{invalid"))
+
+	assert.Error(t, err)
+	assert.Nil(t, d)
+}
@@ -0,0 +1,211 @@
+// Package miner provides the functions to mine a project-specific abbreviation dictionary from Go source code.
+//
+// Abbreviations are mined from definitions on comments, such as "JSON (JavaScript Object Notation)" or
+// "JavaScript Object Notation (JSON)", and from declarations where the name abbreviates its type, such as
+// "ctx context.Context" or "cfg *Config".
+package miner
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/eroatta/token/conserv"
+)
+
+var (
+	// "JSON (JavaScript Object Notation)"
+	shortThenLongRegex = regexp.MustCompile(`\b([A-Za-z][A-Za-z0-9]{1,9})\s+\(([A-Za-z][A-Za-z\- ]+[A-Za-z])\)`)
+	// "JavaScript Object Notation (JSON)"
+	longThenShortRegex = regexp.MustCompile(`((?:[A-Za-z][A-Za-z\-]*\s+){1,10})\(([A-Za-z][A-Za-z0-9]{1,9})\)`)
+)
+
+// MineDir mines the abbreviations defined on every Go file found on the directory and its subdirectories.
+// Hidden directories, "vendor" and "testdata" directories are skipped.
+func MineDir(root string) (*Dictionary, error) {
+	dictionary := NewDictionary()
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		dictionary.MineFile(fset, file)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dictionary, nil
+}
+
+// MineSource mines the abbreviations defined on the given Go source code. The source can be provided
+// as for parser.ParseFile.
+func MineSource(filename string, src interface{}) (*Dictionary, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	dictionary := NewDictionary()
+	dictionary.MineFile(fset, file)
+
+	return dictionary, nil
+}
+
+// MineFile mines the abbreviations defined on the comments and declarations of a parsed Go file,
+// and adds them to the dictionary.
+func (d *Dictionary) MineFile(fset *token.FileSet, file *ast.File) {
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			d.mineComment(fset, comment)
+		}
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.Field:
+			d.mineDeclaration(fset, n.Names, n.Type)
+		case *ast.ValueSpec:
+			d.mineDeclaration(fset, n.Names, n.Type)
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE && len(n.Lhs) == len(n.Rhs) {
+				for i, lhs := range n.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						d.mineDeclaration(fset, []*ast.Ident{ident}, constructedType(n.Rhs[i]))
+					}
+				}
+			}
+		}
+		return true
+	})
+}
+
+// mineComment looks for abbreviations defined as "short (long)" or "long (short)" on a comment.
+func (d *Dictionary) mineComment(fset *token.FileSet, comment *ast.Comment) {
+	location := locationOf(fset, comment.Pos())
+
+	for _, match := range shortThenLongRegex.FindAllStringSubmatch(comment.Text, -1) {
+		shortForm, longForm := match[1], normalize(match[2])
+		if isAbbreviation(shortForm, longForm) {
+			d.Add(shortForm, longForm, location)
+		}
+	}
+
+	for _, match := range longThenShortRegex.FindAllStringSubmatch(comment.Text, -1) {
+		shortForm := match[2]
+		words := strings.Fields(normalize(match[1]))
+
+		// use the shortest trailing sequence of words that defines the short form
+		for k := 1; k <= len(words); k++ {
+			longForm := strings.Join(words[len(words)-k:], " ")
+			if isAbbreviation(shortForm, longForm) {
+				d.Add(shortForm, longForm, location)
+				break
+			}
+		}
+	}
+}
+
+// mineDeclaration looks for names that abbreviate their type name.
+func (d *Dictionary) mineDeclaration(fset *token.FileSet, names []*ast.Ident, typ ast.Expr) {
+	typeName := baseTypeName(typ)
+	if typeName == "" {
+		return
+	}
+
+	longForm := conserv.Split(typeName)
+	for _, name := range names {
+		if isAbbreviation(name.Name, longForm) {
+			d.Add(name.Name, longForm, locationOf(fset, name.Pos()))
+		}
+	}
+}
+
+// constructedType retrieves the type for expressions such as "T{}", "&T{}" or "new(T)".
+func constructedType(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		return e.Type
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return constructedType(e.X)
+		}
+	case *ast.CallExpr:
+		if ident, ok := e.Fun.(*ast.Ident); ok && ident.Name == "new" && len(e.Args) == 1 {
+			return e.Args[0]
+		}
+	}
+
+	return nil
+}
+
+// baseTypeName retrieves the name of a type, without pointers, slices or package names
+// (i.e. "Context" for "context.Context" or "Config" for "*Config").
+func baseTypeName(typ ast.Expr) string {
+	switch t := typ.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return baseTypeName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.ArrayType:
+		return baseTypeName(t.Elt)
+	}
+
+	return ""
+}
+
+// isAbbreviation checks if the short form abbreviates the long form: both start with the same letter,
+// the short form is shorter than the long form, and its letters appear in order on the long form.
+func isAbbreviation(shortForm string, longForm string) bool {
+	short := strings.ToLower(shortForm)
+	long := strings.ReplaceAll(strings.ToLower(longForm), " ", "")
+	if len(short) < 2 || len(short) >= len(long) || short[0] != long[0] {
+		return false
+	}
+
+	i := 0
+	for j := 0; i < len(short) && j < len(long); j++ {
+		if short[i] == long[j] {
+			i++
+		}
+	}
+
+	return i == len(short)
+}
+
+// normalize writes the long form in lower case, with its words separated by single spaces.
+func normalize(longForm string) string {
+	return strings.Join(strings.Fields(strings.ToLower(strings.ReplaceAll(longForm, "-", " "))), " ")
+}
+
+func locationOf(fset *token.FileSet, pos token.Pos) Location {
+	position := fset.Position(pos)
+	return Location{File: position.Filename, Line: position.Line}
+}
@@ -0,0 +1,129 @@
+package miner
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const source = `package sample
+
+import "context"
+
+// JSON (JavaScript Object Notation) is the wire format.
+// Requests are sent using the HyperText Transfer Protocol (HTTP).
+type Config struct{}
+
+type Server struct {
+	cfg *Config
+}
+
+func (srv *Server) Handle(ctx context.Context, req []Request) {
+	var buf Buffer
+	conf := &Config{}
+	mgr := new(Manager)
+	name := "server"
+	_, _, _, _ = buf, conf, mgr, name
+}
+`
+
+func TestMineSource_OnValidSource_ShouldFindAbbreviations(t *testing.T) {
+	dictionary, err := MineSource("sample.go", source)
+
+	assert.NoError(t, err)
+	tests := []struct {
+		name      string
+		shortForm string
+		expected  string
+		line      int
+	}{
+		{"short_then_long_comment", "json", "javascript object notation", 5},
+		{"long_then_short_comment", "http", "hypertext transfer protocol", 6},
+		{"pointer_field", "cfg", "config", 10},
+		{"receiver", "srv", "server", 13},
+		{"selector_parameter", "ctx", "context", 13},
+		{"slice_parameter", "req", "request", 13},
+		{"var_declaration", "buf", "buffer", 14},
+		{"composite_literal", "conf", "config", 15},
+		{"new_call", "mgr", "manager", 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := dictionary.Lookup(tt.shortForm)
+			if assert.Len(t, entries, 1) {
+				assert.Equal(t, tt.expected, entries[0].LongForm)
+				assert.Equal(t, 1, entries[0].Count)
+				assert.Equal(t, []Location{{File: "sample.go", Line: tt.line}}, entries[0].Locations)
+			}
+		})
+	}
+
+	assert.Empty(t, dictionary.Lookup("name"))
+}
+
+func TestMineSource_OnInvalidSource_ShouldReturnError(t *testing.T) {
+	dictionary, err := MineSource("invalid.go", "package")
+
+	assert.Error(t, err)
+	assert.Nil(t, dictionary)
+}
+
+func TestMineDir_OnDirectory_ShouldSkipVendorAndTestdata(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"main.go":            "package main\n\n// DB (database) handle.\nvar db Database\n",
+		"pkg/handler.go":     "package pkg\n\nfunc handle(db Database) {}\n",
+		"pkg/notes.txt":      "// URL (uniform resource locator)\n",
+		"vendor/lib/lib.go":  "package lib\n\nvar cfg Config\n",
+		"testdata/sample.go": "package sample\n\nvar cfg Config\n",
+		".hidden/hidden.go":  "package hidden\n\nvar cfg Config\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	dictionary, err := MineDir(root)
+
+	assert.NoError(t, err)
+	entries := dictionary.Lookup("db")
+	if assert.Len(t, entries, 1) {
+		assert.Equal(t, "database", entries[0].LongForm)
+		assert.Equal(t, 3, entries[0].Count)
+	}
+	assert.Empty(t, dictionary.Lookup("cfg"))
+	assert.Empty(t, dictionary.Lookup("url"))
+}
+
+func TestMineDir_OnMissingDirectory_ShouldReturnError(t *testing.T) {
+	dictionary, err := MineDir(filepath.Join(t.TempDir(), "missing"))
+
+	assert.Error(t, err)
+	assert.Nil(t, dictionary)
+}
+
+func TestIsAbbreviation_OnShortAndLongForms_ShouldCheckDefinition(t *testing.T) {
+	tests := []struct {
+		name      string
+		shortForm string
+		longForm  string
+		expected  bool
+	}{
+		{"ordered_letters", "cfg", "config", true},
+		{"acronym", "JSON", "javascript object notation", true},
+		{"different_first_letter", "xml", "markup language", false},
+		{"unordered_letters", "gfc", "config", false},
+		{"same_length", "config", "config", false},
+		{"single_letter", "c", "config", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isAbbreviation(tt.shortForm, tt.longForm))
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Package pipeline normalises identifiers into index terms, so they can be fed into a search index for
+// code search and traceability. A pipeline chains a set of steps, such as splitting, expansion,
+// lowercasing, stop word removal and stemming, and each identifier goes through them in order.
+package pipeline
+
+import (
+	"strings"
+
+	"github.com/eroatta/token/split"
+)
+
+// Term is an index term produced for an identifier.
+type Term struct {
+	// Text is the normalised term.
+	Text string
+	// Position is the position of the term on the identifier. Positions are kept when terms are
+	// removed, so phrase and proximity queries don't match across removed words.
+	Position int
+	// Offset is the position (in bytes) of the soft word that produced the term on the identifier.
+	Offset int
+	// Source is the soft word that produced the term, or the identifier if it wasn't split.
+	Source string
+}
+
+// Step transforms the terms produced for an identifier.
+type Step func(terms []Term) []Term
+
+// Pipeline runs a set of steps on identifiers.
+type Pipeline struct {
+	steps []Step
+}
+
+// New creates a pipeline that runs the given steps in order.
+func New(steps ...Step) *Pipeline {
+	return &Pipeline{steps: steps}
+}
+
+// Default creates a pipeline that splits the identifier, expands each soft word, lowercases the terms,
+// removes the stop words found on lists.Stop and lists.EnglishStop, and stems the terms.
+func Default(splitter Splitter, expander Expander) *Pipeline {
+	return New(Split(splitter), Expand(expander), Lowercase(), RemoveStopWords(), Stem())
+}
+
+// Normalize runs the steps on the identifier, retrieving its index terms. Before the first step, the
+// identifier is a single term on position 0.
+func (p *Pipeline) Normalize(identifier string) []Term {
+	if strings.TrimSpace(identifier) == "" {
+		return []Term{}
+	}
+
+	terms := []Term{{Text: identifier, Source: identifier}}
+	for _, step := range p.steps {
+		terms = step(terms)
+	}
+
+	return terms
+}
+
+// NormalizeAll runs the steps on each identifier, retrieving the index terms for all of them. Positions
+// continue from one identifier to the next, as if the identifiers were the words of a document.
+func (p *Pipeline) NormalizeAll(identifiers ...string) []Term {
+	all := make([]Term, 0, len(identifiers))
+	next := 0
+	for _, identifier := range identifiers {
+		terms := p.Normalize(identifier)
+		for _, term := range terms {
+			term.Position += next
+			all = append(all, term)
+		}
+		if len(terms) > 0 {
+			next = all[len(all)-1].Position + 1
+		}
+	}
+
+	return all
+}
+
+// Texts returns the text of each term.
+func Texts(terms []Term) []string {
+	texts := make([]string, len(terms))
+	for i, term := range terms {
+		texts[i] = term.Text
+	}
+
+	return texts
+}
+
+// Splitter splits an identifier on its soft words, such as greedy.SplitParts using a list.
+type Splitter func(identifier string) split.Result
+
+// SplitterFunc adapts a splitting function that returns the soft words separated by spaces, such as
+// a closure over conserv.Split, into a Splitter.
+func SplitterFunc(provenance string, fn func(string) string) Splitter {
+	return func(identifier string) split.Result {
+		return split.FromWords(identifier, strings.Fields(fn(identifier)), provenance)
+	}
+}
+
+// Expander retrieves the expansions for a soft word, sorted from the most to the least likely, such as
+// a closure over amap.Expand or basic.Expand.
+type Expander func(word string) []string
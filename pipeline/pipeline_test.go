@@ -0,0 +1,85 @@
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/eroatta/token/conserv"
+	"github.com/eroatta/token/lists"
+	"github.com/stretchr/testify/assert"
+)
+
+var conservSplitter = SplitterFunc("conserv", func(identifier string) string { return conserv.Split(identifier) })
+
+func mapExpander(expansions map[string][]string) Expander {
+	return func(word string) []string {
+		return expansions[word]
+	}
+}
+
+func TestNormalize_OnSteps_ShouldApplyEachStepInOrder(t *testing.T) {
+	expander := mapExpander(map[string][]string{
+		"cfg": {"configuration", "config"},
+		"ifs": {"input file stream"},
+	})
+
+	tests := []struct {
+		name       string
+		identifier string
+		steps      []Step
+		expected   []string
+	}{
+		{"no_steps", "getCfgFile", nil, []string{"getCfgFile"}},
+		{"lowercase", "getCfgFile", []Step{Lowercase()}, []string{"getcfgfile"}},
+		{"split", "getCfgFile", []Step{Split(conservSplitter)}, []string{"get", "cfg", "file"}},
+		{"split_and_expand", "getCfgFile", []Step{Split(conservSplitter), Expand(expander)},
+			[]string{"get", "configuration", "file"}},
+		{"multi_word_expansion", "openIfs", []Step{Split(conservSplitter), Expand(expander)},
+			[]string{"open", "input", "file", "stream"}},
+		{"stop_words", "theNameOfTheFunc", []Step{Split(conservSplitter), RemoveStopWords()},
+			[]string{"name"}},
+		{"custom_stop_words", "theNameOfTheFunc", []Step{Split(conservSplitter),
+			RemoveStopWords(lists.NewBuilder().Add("name").Build())}, []string{"the", "of", "the", "func"}},
+		{"stem", "parsedConnections", []Step{Split(conservSplitter), Stem()}, []string{"pars", "connect"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.steps...).Normalize(tt.identifier)
+
+			assert.Equal(t, tt.expected, Texts(got))
+		})
+	}
+}
+
+func TestNormalize_OnDefaultPipeline_ShouldKeepPositionsAndOffsets(t *testing.T) {
+	expander := mapExpander(map[string][]string{"ifs": {"input file stream"}, "cfg": {"configuration"}})
+
+	got := Default(conservSplitter, expander).Normalize("theIfsOfCfgFiles")
+
+	expected := []Term{
+		{Text: "input", Position: 1, Offset: 3, Source: "ifs"},
+		{Text: "file", Position: 2, Offset: 3, Source: "ifs"},
+		{Text: "stream", Position: 3, Offset: 3, Source: "ifs"},
+		{Text: "configur", Position: 5, Offset: 8, Source: "cfg"},
+		{Text: "file", Position: 6, Offset: 11, Source: "files"},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestNormalize_OnEmptyIdentifier_ShouldReturnNoTerms(t *testing.T) {
+	got := Default(conservSplitter, mapExpander(nil)).Normalize("  ")
+
+	assert.Equal(t, []Term{}, got)
+}
+
+func TestNormalizeAll_OnIdentifiers_ShouldContinuePositions(t *testing.T) {
+	got := New(Split(conservSplitter), RemoveStopWords()).NormalizeAll("readFile", "closeTheFile")
+
+	expected := []Term{
+		{Text: "read", Position: 0, Offset: 0, Source: "read"},
+		{Text: "file", Position: 1, Offset: 4, Source: "file"},
+		{Text: "close", Position: 2, Offset: 0, Source: "close"},
+		{Text: "file", Position: 4, Offset: 8, Source: "file"},
+	}
+	assert.Equal(t, expected, got)
+}
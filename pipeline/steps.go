@@ -0,0 +1,123 @@
+package pipeline
+
+import (
+	"strings"
+
+	"github.com/eroatta/token/lists"
+	porterstemmer "github.com/reiver/go-porterstemmer"
+)
+
+// Split splits each term on its soft words, using the splitter. Each soft word takes its own position.
+func Split(splitter Splitter) Step {
+	return func(terms []Term) []Term {
+		result := make([]Term, 0, len(terms))
+		shift := 0
+		for _, term := range terms {
+			parts := splitter(term.Text)
+			if len(parts) == 0 {
+				term.Position += shift
+				result = append(result, term)
+				continue
+			}
+
+			for i, part := range parts {
+				result = append(result, Term{
+					Text:     part.Word,
+					Position: term.Position + shift + i,
+					Offset:   term.Offset + part.Offset,
+					Source:   part.Word,
+				})
+			}
+			shift += len(parts) - 1
+		}
+
+		return result
+	}
+}
+
+// Expand replaces each term by its most likely expansion, using the expander. Terms without expansions
+// are kept, and multi-word expansions, such as "input stream", produce a term for each word.
+func Expand(expander Expander) Step {
+	return func(terms []Term) []Term {
+		result := make([]Term, 0, len(terms))
+		shift := 0
+		for _, term := range terms {
+			var words []string
+			if expansions := expander(term.Text); len(expansions) > 0 {
+				words = strings.Fields(expansions[0])
+			}
+			if len(words) == 0 {
+				term.Position += shift
+				result = append(result, term)
+				continue
+			}
+
+			for i, word := range words {
+				result = append(result, Term{
+					Text:     word,
+					Position: term.Position + shift + i,
+					Offset:   term.Offset,
+					Source:   term.Source,
+				})
+			}
+			shift += len(words) - 1
+		}
+
+		return result
+	}
+}
+
+// Lowercase turns each term into lower case.
+func Lowercase() Step {
+	return func(terms []Term) []Term {
+		result := make([]Term, len(terms))
+		for i, term := range terms {
+			term.Text = strings.ToLower(term.Text)
+			result[i] = term
+		}
+
+		return result
+	}
+}
+
+// RemoveStopWords removes the terms found on any of the given stop lists. If no list is given,
+// lists.Stop and lists.EnglishStop are used.
+func RemoveStopWords(stopLists ...lists.List) Step {
+	if len(stopLists) == 0 {
+		stopLists = []lists.List{lists.Stop, lists.EnglishStop}
+	}
+
+	return func(terms []Term) []Term {
+		result := make([]Term, 0, len(terms))
+		for _, term := range terms {
+			if !anyContains(stopLists, term.Text) {
+				result = append(result, term)
+			}
+		}
+
+		return result
+	}
+}
+
+func anyContains(stopLists []lists.List, word string) bool {
+	for _, list := range stopLists {
+		if list.Contains(word) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Stem reduces each term to its stem, using the Porter stemmer.
+func Stem() Step {
+	return func(terms []Term) []Term {
+		result := make([]Term, len(terms))
+		for i, term := range terms {
+			term.Text = porterstemmer.StemString(term.Text)
+			result[i] = term
+		}
+
+		return result
+	}
+}
@@ -0,0 +1,203 @@
+// Package rpc serves the splitting and expansion algorithms over gRPC, using the TokenService defined
+// on tokenpb/token.proto. The Go code on package tokenpb is generated from it, running buf generate
+// from this directory.
+package rpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/eroatta/token/rpc/tokenpb"
+	"github.com/eroatta/token/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate buf generate
+
+// algorithms maps the algorithms on the protocol to the names used by the service.
+var algorithms = map[tokenpb.Algorithm]string{
+	tokenpb.Algorithm_ALGORITHM_CONSERV: service.Conserv,
+	tokenpb.Algorithm_ALGORITHM_GREEDY:  service.Greedy,
+	tokenpb.Algorithm_ALGORITHM_SAMURAI: service.Samurai,
+	tokenpb.Algorithm_ALGORITHM_GENTEST: service.GenTest,
+	tokenpb.Algorithm_ALGORITHM_BASIC:   service.Basic,
+	tokenpb.Algorithm_ALGORITHM_AMAP:    service.AMAP,
+}
+
+// Server implements tokenpb.TokenServiceServer on top of a service.Service.
+type Server struct {
+	tokenpb.UnimplementedTokenServiceServer
+	svc *service.Service
+}
+
+// NewServer creates a server that handles the requests using the given service.
+func NewServer(svc *service.Service) *Server {
+	return &Server{svc: svc}
+}
+
+// Register registers a new server for the service on the gRPC server.
+func Register(s *grpc.Server, svc *service.Service) {
+	tokenpb.RegisterTokenServiceServer(s, NewServer(svc))
+}
+
+// Split splits a batch of tokens.
+func (s *Server) Split(ctx context.Context, req *tokenpb.SplitRequest) (*tokenpb.SplitResponse, error) {
+	return s.split(req)
+}
+
+// Expand expands a batch of tokens.
+func (s *Server) Expand(ctx context.Context, req *tokenpb.ExpandRequest) (*tokenpb.ExpandResponse, error) {
+	return s.expand(req)
+}
+
+// SplitStream splits each batch of tokens received on the stream, until the client closes it.
+// An invalid batch ends the stream with its error.
+func (s *Server) SplitStream(stream tokenpb.TokenService_SplitStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.split(req)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// ExpandStream expands each batch of tokens received on the stream, until the client closes it.
+// An invalid batch ends the stream with its error.
+func (s *Server) ExpandStream(stream tokenpb.TokenService_ExpandStreamServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.expand(req)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) split(req *tokenpb.SplitRequest) (*tokenpb.SplitResponse, error) {
+	results, err := s.svc.Split(service.SplitRequest{
+		Algorithm: algorithms[req.GetAlgorithm()],
+		Tokens:    req.GetTokens(),
+		Context:   req.GetContext(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &tokenpb.SplitResponse{
+		Algorithm: req.GetAlgorithm(),
+		Results:   make([]*tokenpb.SplitResult, 0, len(results)),
+	}
+	for _, result := range results {
+		parts := make([]*tokenpb.Part, 0, len(result.Parts))
+		for _, part := range result.Parts {
+			parts = append(parts, &tokenpb.Part{
+				Word:       part.Word,
+				Offset:     int32(part.Offset),
+				Unknown:    part.Unknown,
+				Provenance: part.Provenance,
+			})
+		}
+
+		resp.Results = append(resp.Results, &tokenpb.SplitResult{
+			Token: result.Token,
+			Parts: parts,
+			Error: result.Error,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *Server) expand(req *tokenpb.ExpandRequest) (*tokenpb.ExpandResponse, error) {
+	scope := req.GetScope()
+	results, err := s.svc.Expand(service.ExpandRequest{
+		Algorithm:   algorithms[req.GetAlgorithm()],
+		Tokens:      req.GetTokens(),
+		SourceWords: req.GetSourceWords(),
+		Phrases:     req.GetPhrases(),
+		Scope: service.Scope{
+			VariableDeclarations: scope.GetVariableDeclarations(),
+			MethodName:           scope.GetMethodName(),
+			MethodBodyText:       scope.GetMethodBodyText(),
+			MethodComments:       scope.GetMethodComments(),
+			Statements:           scope.GetStatements(),
+			Identifiers:          scope.GetIdentifiers(),
+			ReceiverType:         scope.GetReceiverType(),
+			StructFields:         scope.GetStructFields(),
+			FileComments:         scope.GetFileComments(),
+			PackageComments:      scope.GetPackageComments(),
+			Imports:              scope.GetImports(),
+		},
+		ReferenceText: req.GetReferenceText(),
+		Context:       req.GetContext(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &tokenpb.ExpandResponse{
+		Algorithm: req.GetAlgorithm(),
+		Results:   make([]*tokenpb.ExpandResult, 0, len(results)),
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, &tokenpb.ExpandResult{
+			Token:      result.Token,
+			Candidates: candidates(req.GetAlgorithm(), result.Expansions),
+			Error:      result.Error,
+		})
+	}
+
+	return resp, nil
+}
+
+// candidates ranks the expansions on the order given by the algorithm. GenTest expands the whole token
+// into a sequence of words, so they're joined into a single candidate.
+func candidates(algorithm tokenpb.Algorithm, expansions []string) []*tokenpb.Candidate {
+	if algorithm == tokenpb.Algorithm_ALGORITHM_GENTEST && len(expansions) > 0 {
+		expansions = []string{strings.Join(expansions, " ")}
+	}
+
+	candidates := make([]*tokenpb.Candidate, 0, len(expansions))
+	for i, expansion := range expansions {
+		candidates = append(candidates, &tokenpb.Candidate{Expansion: expansion, Rank: int32(i + 1)})
+	}
+
+	return candidates
+}
+
+// toStatus converts an error on the request into a gRPC status error.
+func toStatus(err error) error {
+	code := codes.InvalidArgument
+	if errors.Is(err, service.ErrTooManyTokens) {
+		code = codes.ResourceExhausted
+	}
+
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,203 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/gentest"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/rpc/tokenpb"
+	"github.com/eroatta/token/service"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T, options ...service.Option) tokenpb.TokenServiceClient {
+	similarity := gentest.NewSimilarityTable()
+	similarity.Set("http", "response", 0.9)
+
+	defaults := []service.Option{
+		service.WithGreedyList(lists.NewBuilder().Add("get", "string", "http", "response").Build()),
+		service.WithExpansions(expansion.NewSetBuilder().AddStrings("http", "response", "parser").Build()),
+		service.WithSimilarity(similarity),
+	}
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	Register(server, service.New(append(defaults, options...)...))
+	go server.Serve(listener)
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("dialing bufconn: %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return tokenpb.NewTokenServiceClient(conn)
+}
+
+func TestSplit_OnServer_ShouldReturnPartsWithOffsetsAndProvenance(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.Split(context.Background(), &tokenpb.SplitRequest{
+		Algorithm: tokenpb.Algorithm_ALGORITHM_GREEDY,
+		Tokens:    []string{"getstring", "httpResponse"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, tokenpb.Algorithm_ALGORITHM_GREEDY, resp.GetAlgorithm())
+	assert.Equal(t, 2, len(resp.GetResults()))
+
+	first := resp.GetResults()[0]
+	assert.Equal(t, "getstring", first.GetToken())
+	assert.Equal(t, 2, len(first.GetParts()))
+	assert.Equal(t, "get", first.GetParts()[0].GetWord())
+	assert.Equal(t, int32(0), first.GetParts()[0].GetOffset())
+	assert.Equal(t, "string", first.GetParts()[1].GetWord())
+	assert.Equal(t, int32(3), first.GetParts()[1].GetOffset())
+	assert.Equal(t, "greedy", first.GetParts()[1].GetProvenance())
+
+	second := resp.GetResults()[1]
+	assert.Equal(t, "response", second.GetParts()[1].GetWord())
+	assert.Equal(t, int32(4), second.GetParts()[1].GetOffset())
+}
+
+func TestSplit_OnServerWithTokenError_ShouldReportItOnTheResult(t *testing.T) {
+	client := newTestClient(t)
+
+	resp, err := client.Split(context.Background(), &tokenpb.SplitRequest{
+		Algorithm: tokenpb.Algorithm_ALGORITHM_CONSERV,
+		Tokens:    []string{""},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "empty token", resp.GetResults()[0].GetError())
+	assert.Empty(t, resp.GetResults()[0].GetParts())
+}
+
+func TestSplit_OnServerWithInvalidRequest_ShouldReturnStatusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *tokenpb.SplitRequest
+		expected codes.Code
+	}{
+		{"unspecified_algorithm", &tokenpb.SplitRequest{Tokens: []string{"getString"}}, codes.InvalidArgument},
+		{"expansion_algorithm", &tokenpb.SplitRequest{Algorithm: tokenpb.Algorithm_ALGORITHM_AMAP, Tokens: []string{"getString"}},
+			codes.InvalidArgument},
+		{"no_tokens", &tokenpb.SplitRequest{Algorithm: tokenpb.Algorithm_ALGORITHM_CONSERV}, codes.InvalidArgument},
+		{"too_many_tokens", &tokenpb.SplitRequest{Algorithm: tokenpb.Algorithm_ALGORITHM_CONSERV, Tokens: []string{"a", "b", "c"}},
+			codes.ResourceExhausted},
+	}
+
+	client := newTestClient(t, service.WithMaxTokens(2))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Split(context.Background(), tt.req)
+
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.expected, status.Code(err))
+		})
+	}
+}
+
+func TestExpand_OnServer_ShouldReturnRankedCandidates(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *tokenpb.ExpandRequest
+		expected []*tokenpb.Candidate
+	}{
+		{"basic", &tokenpb.ExpandRequest{
+			Algorithm:   tokenpb.Algorithm_ALGORITHM_BASIC,
+			Tokens:      []string{"pars"},
+			SourceWords: []string{"parser"},
+		}, []*tokenpb.Candidate{{Expansion: "parser", Rank: 1}}},
+		{"amap", &tokenpb.ExpandRequest{
+			Algorithm: tokenpb.Algorithm_ALGORITHM_AMAP,
+			Tokens:    []string{"resp"},
+			Scope:     &tokenpb.Scope{MethodBodyText: "resp := handle(response)"},
+		}, []*tokenpb.Candidate{{Expansion: "response", Rank: 1}}},
+		{"gentest", &tokenpb.ExpandRequest{
+			Algorithm: tokenpb.Algorithm_ALGORITHM_GENTEST,
+			Tokens:    []string{"httpresp"},
+			Context:   []string{"http", "response"},
+		}, []*tokenpb.Candidate{{Expansion: "http response", Rank: 1}}},
+	}
+
+	client := newTestClient(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.Expand(context.Background(), tt.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(resp.GetResults()))
+			got := resp.GetResults()[0].GetCandidates()
+			assert.Equal(t, len(tt.expected), len(got))
+			for i := range tt.expected {
+				assert.Equal(t, tt.expected[i].GetExpansion(), got[i].GetExpansion())
+				assert.Equal(t, tt.expected[i].GetRank(), got[i].GetRank())
+			}
+		})
+	}
+}
+
+func TestSplitStream_OnServer_ShouldReplyToEachBatch(t *testing.T) {
+	client := newTestClient(t)
+
+	stream, err := client.SplitStream(context.Background())
+	assert.NoError(t, err)
+
+	batches := [][]string{{"getstring"}, {"httpresponse", "getString"}}
+	for _, batch := range batches {
+		err := stream.Send(&tokenpb.SplitRequest{Algorithm: tokenpb.Algorithm_ALGORITHM_GREEDY, Tokens: batch})
+		assert.NoError(t, err)
+
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, len(batch), len(resp.GetResults()))
+		assert.Equal(t, batch[0], resp.GetResults()[0].GetToken())
+	}
+
+	assert.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestExpandStream_OnServerWithInvalidBatch_ShouldEndTheStream(t *testing.T) {
+	client := newTestClient(t)
+
+	stream, err := client.ExpandStream(context.Background())
+	assert.NoError(t, err)
+
+	err = stream.Send(&tokenpb.ExpandRequest{
+		Algorithm:   tokenpb.Algorithm_ALGORITHM_BASIC,
+		Tokens:      []string{"pars"},
+		SourceWords: []string{"parser"},
+	})
+	assert.NoError(t, err)
+
+	resp, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "parser", resp.GetResults()[0].GetCandidates()[0].GetExpansion())
+
+	err = stream.Send(&tokenpb.ExpandRequest{Algorithm: tokenpb.Algorithm_ALGORITHM_CONSERV, Tokens: []string{"pars"}})
+	assert.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
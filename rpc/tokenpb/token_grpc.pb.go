@@ -0,0 +1,286 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: tokenpb/token.proto
+
+package tokenpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TokenServiceClient is the client API for TokenService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TokenServiceClient interface {
+	// Split splits a batch of tokens.
+	Split(ctx context.Context, in *SplitRequest, opts ...grpc.CallOption) (*SplitResponse, error)
+	// Expand expands a batch of tokens.
+	Expand(ctx context.Context, in *ExpandRequest, opts ...grpc.CallOption) (*ExpandResponse, error)
+	// SplitStream splits each batch of tokens received on the stream, replying with a response per batch.
+	SplitStream(ctx context.Context, opts ...grpc.CallOption) (TokenService_SplitStreamClient, error)
+	// ExpandStream expands each batch of tokens received on the stream, replying with a response per batch.
+	ExpandStream(ctx context.Context, opts ...grpc.CallOption) (TokenService_ExpandStreamClient, error)
+}
+
+type tokenServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTokenServiceClient(cc grpc.ClientConnInterface) TokenServiceClient {
+	return &tokenServiceClient{cc}
+}
+
+func (c *tokenServiceClient) Split(ctx context.Context, in *SplitRequest, opts ...grpc.CallOption) (*SplitResponse, error) {
+	out := new(SplitResponse)
+	err := c.cc.Invoke(ctx, "/token.v1.TokenService/Split", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) Expand(ctx context.Context, in *ExpandRequest, opts ...grpc.CallOption) (*ExpandResponse, error) {
+	out := new(ExpandResponse)
+	err := c.cc.Invoke(ctx, "/token.v1.TokenService/Expand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tokenServiceClient) SplitStream(ctx context.Context, opts ...grpc.CallOption) (TokenService_SplitStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TokenService_ServiceDesc.Streams[0], "/token.v1.TokenService/SplitStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tokenServiceSplitStreamClient{stream}
+	return x, nil
+}
+
+type TokenService_SplitStreamClient interface {
+	Send(*SplitRequest) error
+	Recv() (*SplitResponse, error)
+	grpc.ClientStream
+}
+
+type tokenServiceSplitStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tokenServiceSplitStreamClient) Send(m *SplitRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tokenServiceSplitStreamClient) Recv() (*SplitResponse, error) {
+	m := new(SplitResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *tokenServiceClient) ExpandStream(ctx context.Context, opts ...grpc.CallOption) (TokenService_ExpandStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TokenService_ServiceDesc.Streams[1], "/token.v1.TokenService/ExpandStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &tokenServiceExpandStreamClient{stream}
+	return x, nil
+}
+
+type TokenService_ExpandStreamClient interface {
+	Send(*ExpandRequest) error
+	Recv() (*ExpandResponse, error)
+	grpc.ClientStream
+}
+
+type tokenServiceExpandStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *tokenServiceExpandStreamClient) Send(m *ExpandRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *tokenServiceExpandStreamClient) Recv() (*ExpandResponse, error) {
+	m := new(ExpandResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TokenServiceServer is the server API for TokenService service.
+// All implementations must embed UnimplementedTokenServiceServer
+// for forward compatibility
+type TokenServiceServer interface {
+	// Split splits a batch of tokens.
+	Split(context.Context, *SplitRequest) (*SplitResponse, error)
+	// Expand expands a batch of tokens.
+	Expand(context.Context, *ExpandRequest) (*ExpandResponse, error)
+	// SplitStream splits each batch of tokens received on the stream, replying with a response per batch.
+	SplitStream(TokenService_SplitStreamServer) error
+	// ExpandStream expands each batch of tokens received on the stream, replying with a response per batch.
+	ExpandStream(TokenService_ExpandStreamServer) error
+	mustEmbedUnimplementedTokenServiceServer()
+}
+
+// UnimplementedTokenServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTokenServiceServer struct {
+}
+
+func (UnimplementedTokenServiceServer) Split(context.Context, *SplitRequest) (*SplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Split not implemented")
+}
+func (UnimplementedTokenServiceServer) Expand(context.Context, *ExpandRequest) (*ExpandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Expand not implemented")
+}
+func (UnimplementedTokenServiceServer) SplitStream(TokenService_SplitStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SplitStream not implemented")
+}
+func (UnimplementedTokenServiceServer) ExpandStream(TokenService_ExpandStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExpandStream not implemented")
+}
+func (UnimplementedTokenServiceServer) mustEmbedUnimplementedTokenServiceServer() {}
+
+// UnsafeTokenServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TokenServiceServer will
+// result in compilation errors.
+type UnsafeTokenServiceServer interface {
+	mustEmbedUnimplementedTokenServiceServer()
+}
+
+func RegisterTokenServiceServer(s grpc.ServiceRegistrar, srv TokenServiceServer) {
+	s.RegisterService(&TokenService_ServiceDesc, srv)
+}
+
+func _TokenService_Split_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).Split(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/token.v1.TokenService/Split",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).Split(ctx, req.(*SplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_Expand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TokenServiceServer).Expand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/token.v1.TokenService/Expand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TokenServiceServer).Expand(ctx, req.(*ExpandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TokenService_SplitStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TokenServiceServer).SplitStream(&tokenServiceSplitStreamServer{stream})
+}
+
+type TokenService_SplitStreamServer interface {
+	Send(*SplitResponse) error
+	Recv() (*SplitRequest, error)
+	grpc.ServerStream
+}
+
+type tokenServiceSplitStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tokenServiceSplitStreamServer) Send(m *SplitResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tokenServiceSplitStreamServer) Recv() (*SplitRequest, error) {
+	m := new(SplitRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _TokenService_ExpandStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TokenServiceServer).ExpandStream(&tokenServiceExpandStreamServer{stream})
+}
+
+type TokenService_ExpandStreamServer interface {
+	Send(*ExpandResponse) error
+	Recv() (*ExpandRequest, error)
+	grpc.ServerStream
+}
+
+type tokenServiceExpandStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *tokenServiceExpandStreamServer) Send(m *ExpandResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *tokenServiceExpandStreamServer) Recv() (*ExpandRequest, error) {
+	m := new(ExpandRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TokenService_ServiceDesc is the grpc.ServiceDesc for TokenService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TokenService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "token.v1.TokenService",
+	HandlerType: (*TokenServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Split",
+			Handler:    _TokenService_Split_Handler,
+		},
+		{
+			MethodName: "Expand",
+			Handler:    _TokenService_Expand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SplitStream",
+			Handler:       _TokenService_SplitStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExpandStream",
+			Handler:       _TokenService_ExpandStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "tokenpb/token.proto",
+}
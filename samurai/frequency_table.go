@@ -1,7 +1,11 @@
 package samurai
 
 import (
+	"bufio"
 	"errors"
+	"fmt"
+	"io"
+	"strconv"
 	"strings"
 )
 
@@ -24,6 +28,40 @@ func NewFrequencyTable() *FrequencyTable {
 	}
 }
 
+// NewFrequencyTableFromReader builds a frequency table from a reader, where each line holds a token
+// and its number of occurrences, separated by spaces. Empty lines or lines starting with # are ignored.
+func NewFrequencyTableFromReader(r io.Reader) (*FrequencyTable, error) {
+	table := NewFrequencyTable()
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected token and occurrences, found %q", n, line)
+		}
+
+		occurrences, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+
+		if err := table.SetOccurrences(fields[0], occurrences); err != nil {
+			return nil, fmt.Errorf("line %d: %v", n, err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
 // SetOccurrences sets how many times a token appeared in a set of strings.
 func (f *FrequencyTable) SetOccurrences(token string, occurrences int) error {
 	if occurrences < 0 {
@@ -1,6 +1,7 @@
 package samurai
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -87,3 +88,33 @@ func TestSetOccurrences_WithExistingTokenOnTable_ShouldDecreaseTokenAndTotalValu
 	assert.Equal(t, 0.25, freq, "frequency for the given token should match")
 	assert.Equal(t, 4, total, "total number of occurrences should match")
 }
+
+func TestNewFrequencyTableFromReader_OnValidInput_ShouldReturnFrequencyTable(t *testing.T) {
+	input := "# token occurrences\nget 3\n\nString 10\n"
+
+	ft, err := NewFrequencyTableFromReader(strings.NewReader(input))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 13, ft.TotalOccurrences())
+	assert.Equal(t, float64(10)/float64(13), ft.Frequency("string"))
+}
+
+func TestNewFrequencyTableFromReader_OnInvalidInput_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"missing_occurrences", "get\n"},
+		{"invalid_occurrences", "get three\n"},
+		{"negative_occurrences", "get -1\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ft, err := NewFrequencyTableFromReader(strings.NewReader(tt.input))
+
+			assert.Error(t, err)
+			assert.Nil(t, ft)
+		})
+	}
+}
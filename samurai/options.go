@@ -0,0 +1,32 @@
+package samurai
+
+import "github.com/eroatta/token/marker"
+
+// Option configures the behaviour of Samurai.
+type Option func(*config)
+
+type config struct {
+	digits marker.DigitPolicy
+}
+
+func newConfig(options []Option) config {
+	conf := config{
+		digits: marker.KeepDigits,
+	}
+	for _, option := range options {
+		option(&conf)
+	}
+
+	return conf
+}
+
+// WithDigitPolicy sets the policy used to split letters and digits.
+// By default, the marker.KeepDigits policy is used, so letters and digits are kept together as on
+// previous versions.
+func WithDigitPolicy(policy marker.DigitPolicy) Option {
+	return func(c *config) {
+		if policy != nil {
+			c.digits = policy
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/marker"
 
 	"github.com/eroatta/token/lists"
@@ -44,9 +45,14 @@ func NewTokenContext(local *FrequencyTable, global *FrequencyTable) TokenContext
 
 // Split on Samurai receives a token and returns a string of hard/soft words separated by the defined separator,
 // split by the Samurai algorithm proposed by Hill et all.
-func Split(token string, tCtx TokenContext, prefixes lists.List, suffixes lists.List) string {
-	preprocessedToken := marker.OnDigits(token)
-	preprocessedToken = marker.OnLowerToUpperCase(token)
+//
+// The behaviour can be customized using options, such as WithDigitPolicy. Letters and digits are kept
+// together by default.
+func Split(token string, tCtx TokenContext, prefixes lists.List, suffixes lists.List, options ...Option) string {
+	conf := newConfig(options)
+
+	preprocessedToken := conf.digits(token)
+	preprocessedToken = marker.OnLowerToUpperCase(preprocessedToken)
 	preprocessedToken = strings.ToLower(preprocessedToken)
 
 	var processedToken string
@@ -85,6 +91,25 @@ func Split(token string, tCtx TokenContext, prefixes lists.List, suffixes lists.
 	return strings.Join(splitToken, Separator)
 }
 
+// TrySplit on Samurai works as Split, but returns an error when the token can't be split because of
+// the input: errs.ErrEmptyToken for an empty token, errs.ErrEmptyContext when any of the frequency
+// tables of the token context is missing, or errs.ErrNilList when the prefixes or suffixes lists are nil.
+func TrySplit(token string, tCtx TokenContext, prefixes lists.List, suffixes lists.List, options ...Option) (string, error) {
+	if strings.TrimSpace(token) == "" {
+		return "", errs.ErrEmptyToken
+	}
+
+	if tCtx.local == nil || tCtx.global == nil {
+		return "", errs.ErrEmptyContext
+	}
+
+	if prefixes == nil || suffixes == nil {
+		return "", errs.ErrNilList
+	}
+
+	return Split(token, tCtx, prefixes, suffixes, options...), nil
+}
+
 func sameCaseSplit(token string, tCtx TokenContext, prefixes lists.List, suffixes lists.List, baseScore float64) []string {
 	maxScore := -1.0
 
@@ -1,9 +1,12 @@
 package samurai
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/eroatta/token/errs"
 	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/marker"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -32,6 +35,29 @@ func TestSplit_ShouldReturnValidSplits(t *testing.T) {
 	}
 }
 
+func TestSplit_OnDigitPolicies_ShouldHandleDigits(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy marker.DigitPolicy
+		want   string
+	}{
+		{"keep_by_default", nil, "utf8string"},
+		{"split_digits", marker.SplitDigits, "utf 8 string"},
+		{"keep_digits", marker.KeepDigits, "utf8string"},
+		{"glue_digits", marker.GlueDigits(marker.DigitWords), "utf8 string"},
+	}
+
+	tCtx := NewTokenContext(createTestFrequencyTable(), createTestGlobalFrequencyTable())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split("utf8String", tCtx, lists.Prefixes, lists.Suffixes, WithDigitPolicy(tt.policy))
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func createTestFrequencyTable() *FrequencyTable {
 	ft := NewFrequencyTable()
 	ft.SetOccurrences("get", 3)
@@ -78,3 +104,30 @@ func BenchmarkSamuraiSplitting(b *testing.B) {
 		Split("notype", tCtx, lists.Prefixes, lists.Suffixes)
 	}
 }
+
+func TestTrySplit_OnSamurai_ShouldReturnSplitsOrError(t *testing.T) {
+	validCtx := NewTokenContext(createTestFrequencyTable(), createTestGlobalFrequencyTable())
+	tests := []struct {
+		name     string
+		token    string
+		tCtx     TokenContext
+		prefixes lists.List
+		expected string
+		err      error
+	}{
+		{"valid_token", "getString", validCtx, lists.Prefixes, "get string", nil},
+		{"empty_token", "", validCtx, lists.Prefixes, "", errs.ErrEmptyToken},
+		{"empty_context", "getString", TokenContext{}, lists.Prefixes, "", errs.ErrEmptyContext},
+		{"missing_global_table", "getString", NewTokenContext(createTestFrequencyTable(), nil), lists.Prefixes, "", errs.ErrEmptyContext},
+		{"nil_prefixes", "getString", validCtx, nil, "", errs.ErrNilList},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TrySplit(tt.token, tt.tCtx, tt.prefixes, lists.Suffixes)
+
+			assert.Equal(t, tt.expected, got)
+			assert.True(t, errors.Is(err, tt.err))
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// maxBodyBytes limits the size of a request body.
+const maxBodyBytes = 1 << 20
+
+// NewHandler creates the HTTP handler for the service, with the following endpoints:
+// * POST /split: splits a batch of tokens (see SplitRequest and SplitResult).
+// * POST /expand: expands a batch of tokens (see ExpandRequest and ExpandResult).
+// * GET /healthz: reports that the service is up.
+// * GET /metrics: reports the requests, tokens and errors for each operation, algorithm and response
+// status code, on the Prometheus text format. Failed requests are reported too.
+func NewHandler(s *Service) http.Handler {
+	m := newMetrics()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/split", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		var req SplitRequest
+		if status := decode(w, r, &req); status != http.StatusOK {
+			m.observe("split", unknownAlgorithm, status, 0, 0, time.Since(start))
+			return
+		}
+
+		results, err := s.Split(req)
+		if err != nil {
+			status := writeError(w, err)
+			m.observe("split", algorithmLabel(req.Algorithm, err), status, 0, 0, time.Since(start))
+			return
+		}
+
+		failed := 0
+		for _, result := range results {
+			if result.Error != "" {
+				failed++
+			}
+		}
+		m.observe("split", strings.ToLower(req.Algorithm), http.StatusOK, len(results), failed, time.Since(start))
+
+		writeJSON(w, http.StatusOK, splitResponse{Algorithm: req.Algorithm, Results: results})
+	})
+
+	mux.HandleFunc("/expand", func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		var req ExpandRequest
+		if status := decode(w, r, &req); status != http.StatusOK {
+			m.observe("expand", unknownAlgorithm, status, 0, 0, time.Since(start))
+			return
+		}
+
+		results, err := s.Expand(req)
+		if err != nil {
+			status := writeError(w, err)
+			m.observe("expand", algorithmLabel(req.Algorithm, err), status, 0, 0, time.Since(start))
+			return
+		}
+
+		failed := 0
+		for _, result := range results {
+			if result.Error != "" {
+				failed++
+			}
+		}
+		m.observe("expand", strings.ToLower(req.Algorithm), http.StatusOK, len(results), failed, time.Since(start))
+
+		writeJSON(w, http.StatusOK, expandResponse{Algorithm: req.Algorithm, Results: results})
+	})
+
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		m.write(w)
+	})
+
+	return mux
+}
+
+type splitResponse struct {
+	Algorithm string        `json:"algorithm"`
+	Results   []SplitResult `json:"results"`
+}
+
+type expandResponse struct {
+	Algorithm string         `json:"algorithm"`
+	Results   []ExpandResult `json:"results"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// decode reads the JSON request body into v, writing the error response if it fails. It returns
+// http.StatusOK if the body was decoded, or the status code of the error response.
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) int {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "method not allowed"})
+		return http.StatusMethodNotAllowed
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid request: " + err.Error()})
+		return http.StatusBadRequest
+	}
+
+	return http.StatusOK
+}
+
+// writeError writes the error response for a request rejected by the service, and returns its status code.
+func writeError(w http.ResponseWriter, err error) int {
+	status := http.StatusBadRequest
+	if errors.Is(err, ErrTooManyTokens) {
+		status = http.StatusRequestEntityTooLarge
+	}
+
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+	return status
+}
+
+// algorithmLabel retrieves the algorithm label for a request rejected by the service.
+func algorithmLabel(algorithm string, err error) string {
+	if errors.Is(err, ErrUnknownAlgorithm) {
+		return unknownAlgorithm
+	}
+
+	return strings.ToLower(algorithm)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eroatta/token/split"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler_OnSplit_ShouldReturnResults(t *testing.T) {
+	server := httptest.NewServer(NewHandler(newTestService()))
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+"/split", "application/json",
+		strings.NewReader(`{"algorithm": "conserv", "tokens": ["httpResponse", "parseURLs"]}`))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `{"word":"response","offset":4,"unknown":false,"provenance":"conserv"}`)
+
+	var got splitResponse
+	assert.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	assert.Equal(t, splitResponse{Algorithm: "conserv", Results: []SplitResult{
+		{Token: "httpResponse", Words: []string{"http", "response"}, Parts: split.Result{
+			{Word: "http", Offset: 0, Provenance: "conserv"},
+			{Word: "response", Offset: 4, Provenance: "conserv"},
+		}},
+		{Token: "parseURLs", Words: []string{"parse", "urls"}, Parts: split.Result{
+			{Word: "parse", Offset: 0, Provenance: "conserv"},
+			{Word: "urls", Offset: 5, Provenance: "conserv"},
+		}},
+	}}, got)
+}
+
+func TestHandler_OnExpand_ShouldReturnResults(t *testing.T) {
+	server := httptest.NewServer(NewHandler(newTestService()))
+	defer server.Close()
+
+	body := `{
+		"algorithm": "amap",
+		"tokens": ["jpf"],
+		"scope": {"identifiers": ["jsonParserFactory"]},
+		"reference_text": ["json parser factory"]
+	}`
+	resp, err := http.Post(server.URL+"/expand", "application/json", strings.NewReader(body))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+
+	var got expandResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, expandResponse{Algorithm: "amap", Results: []ExpandResult{
+		{Token: "jpf", Expansions: []string{"json parser factory"}},
+	}}, got)
+}
+
+func TestHandler_OnInvalidRequests_ShouldReturnErrorStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		expected int
+	}{
+		{"invalid_json", http.MethodPost, "/split", `{"algorithm": `, http.StatusBadRequest},
+		{"unknown_field", http.MethodPost, "/split", `{"algorithm": "conserv", "words": ["a"]}`, http.StatusBadRequest},
+		{"unknown_algorithm", http.MethodPost, "/expand", `{"algorithm": "conserv", "tokens": ["a"]}`, http.StatusBadRequest},
+		{"no_tokens", http.MethodPost, "/split", `{"algorithm": "conserv", "tokens": []}`, http.StatusBadRequest},
+		{"too_many_tokens", http.MethodPost, "/split", `{"algorithm": "conserv", "tokens": ["a", "b", "c"]}`, http.StatusRequestEntityTooLarge},
+		{"wrong_method_on_split", http.MethodGet, "/split", "", http.StatusMethodNotAllowed},
+		{"wrong_method_on_health", http.MethodPost, "/healthz", "", http.StatusMethodNotAllowed},
+		{"unknown_path", http.MethodGet, "/unknown", "", http.StatusNotFound},
+	}
+
+	handler := NewHandler(newTestService(WithMaxTokens(2)))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expected, rec.Code)
+		})
+	}
+}
+
+func TestHandler_OnHealth_ShouldReturnOK(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	NewHandler(newTestService()).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status": "ok"}`, rec.Body.String())
+}
+
+func TestHandler_OnMetrics_ShouldReturnCounters(t *testing.T) {
+	handler := NewHandler(newTestService())
+	for _, body := range []string{
+		`{"algorithm": "conserv", "tokens": ["httpResponse", ""]}`,
+		`{"algorithm": "conserv", "tokens": ["parseURLs"]}`,
+		`{"algorithm": "conserv", "tokens": []}`,
+		`{"algorithm": "samurai2", "tokens": ["parseURLs"]}`,
+		`{"algorithm": `,
+	} {
+		handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/split", strings.NewReader(body)))
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body, _ := ioutil.ReadAll(rec.Body)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, string(body), `tokend_requests_total{operation="split",algorithm="conserv",status="200"} 2`)
+	assert.Contains(t, string(body), `tokend_tokens_total{operation="split",algorithm="conserv",status="200"} 3`)
+	assert.Contains(t, string(body), `tokend_token_errors_total{operation="split",algorithm="conserv",status="200"} 1`)
+	assert.Contains(t, string(body), `tokend_requests_total{operation="split",algorithm="conserv",status="400"} 1`)
+	assert.Contains(t, string(body), `tokend_requests_total{operation="split",algorithm="unknown",status="400"} 2`)
+	assert.NotContains(t, string(body), "samurai2")
+	assert.Contains(t, string(body), "# TYPE tokend_request_duration_seconds_total counter")
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+// metrics holds the counters exposed by the metrics endpoint, written on the Prometheus text format.
+type metrics struct {
+	mu       sync.Mutex
+	requests map[metricKey]int
+	tokens   map[metricKey]int
+	errors   map[metricKey]int
+	seconds  map[metricKey]float64
+}
+
+// unknownAlgorithm is the algorithm label of the requests that couldn't be decoded or named an unsupported
+// algorithm, so the labels don't hold any name sent by the clients.
+const unknownAlgorithm = "unknown"
+
+// metricKey identifies the counters for an operation, algorithm and response status code.
+type metricKey struct {
+	operation string
+	algorithm string
+	status    int
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests: make(map[metricKey]int),
+		tokens:   make(map[metricKey]int),
+		errors:   make(map[metricKey]int),
+		seconds:  make(map[metricKey]float64),
+	}
+}
+
+// observe records a request for the operation and algorithm, with its response status code, its number
+// of tokens, its number of failed tokens and its duration.
+func (m *metrics) observe(operation string, algorithm string, status int, tokens int, failed int,
+	elapsed time.Duration) {
+	key := metricKey{operation: operation, algorithm: algorithm, status: status}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests[key]++
+	m.tokens[key] += tokens
+	m.errors[key] += failed
+	m.seconds[key] += elapsed.Seconds()
+}
+
+// write writes every counter on the Prometheus text format.
+func (m *metrics) write(w io.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	keys := make([]metricKey, 0, len(m.requests))
+	for key := range m.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].operation != keys[j].operation {
+			return keys[i].operation < keys[j].operation
+		}
+		if keys[i].algorithm != keys[j].algorithm {
+			return keys[i].algorithm < keys[j].algorithm
+		}
+		return keys[i].status < keys[j].status
+	})
+
+	counters := []struct {
+		name   string
+		help   string
+		values func(metricKey) string
+	}{
+		{"tokend_requests_total", "Number of requests.", func(k metricKey) string { return fmt.Sprint(m.requests[k]) }},
+		{"tokend_tokens_total", "Number of processed tokens.", func(k metricKey) string { return fmt.Sprint(m.tokens[k]) }},
+		{"tokend_token_errors_total", "Number of tokens that couldn't be processed.", func(k metricKey) string { return fmt.Sprint(m.errors[k]) }},
+		{"tokend_request_duration_seconds_total", "Total time spent processing requests.", func(k metricKey) string { return fmt.Sprint(m.seconds[k]) }},
+	}
+
+	for _, counter := range counters {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s counter\n", counter.name, counter.help, counter.name)
+		for _, key := range keys {
+			fmt.Fprintf(w, "%s{operation=%q,algorithm=%q,status=\"%d\"} %s\n", counter.name, key.operation,
+				key.algorithm, key.status, counter.values(key))
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/gentest"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/samurai"
+)
+
+// DefaultMaxTokens is the default maximum number of tokens on a single request.
+const DefaultMaxTokens = 1000
+
+// Option sets a resource or a setting for the service.
+type Option func(*Service)
+
+// WithGreedyList sets the list used by Greedy, instead of greedy.DefaultList.
+func WithGreedyList(list lists.List) Option {
+	return func(s *Service) {
+		s.greedyList = list
+	}
+}
+
+// WithExpansions sets the set of possible expansions used by Basic and GenTest, instead of
+// basic.DefaultExpansions.
+func WithExpansions(set expansion.Set) Option {
+	return func(s *Service) {
+		s.expansions = set
+	}
+}
+
+// WithFrequencyTables sets the local and global frequency tables used by Samurai.
+// Samurai can't split tokens without them.
+func WithFrequencyTables(local *samurai.FrequencyTable, global *samurai.FrequencyTable) Option {
+	return func(s *Service) {
+		s.tokenCtx = samurai.NewTokenContext(local, global)
+	}
+}
+
+// WithSimilarity sets the similarity calculator used by GenTest.
+// GenTest can't split or expand tokens without it.
+func WithSimilarity(calculator gentest.SimilarityCalculator) Option {
+	return func(s *Service) {
+		s.similarity = calculator
+	}
+}
+
+// WithMaxTokens sets the maximum number of tokens on a single request. Zero or less means no limit.
+func WithMaxTokens(max int) Option {
+	return func(s *Service) {
+		s.maxTokens = max
+	}
+}
+
+// LoadTables loads the resources found on the given files, returning the options that set them: the local
+// and global frequency tables used by Samurai, and the similarity table used by GenTest. Empty paths are
+// skipped, but the frequency tables must be given together; otherwise, an ErrMissingFrequencyTable error
+// is returned.
+func LoadTables(localFrequencies string, globalFrequencies string, similarity string) ([]Option, error) {
+	var options []Option
+
+	switch {
+	case localFrequencies != "" && globalFrequencies != "":
+		local, err := loadFrequencyTable(localFrequencies)
+		if err != nil {
+			return nil, fmt.Errorf("loading local frequency table: %w", err)
+		}
+		global, err := loadFrequencyTable(globalFrequencies)
+		if err != nil {
+			return nil, fmt.Errorf("loading global frequency table: %w", err)
+		}
+		options = append(options, WithFrequencyTables(local, global))
+	case localFrequencies != "":
+		return nil, fmt.Errorf("%w: the global frequency table is required along with the local one",
+			ErrMissingFrequencyTable)
+	case globalFrequencies != "":
+		return nil, fmt.Errorf("%w: the local frequency table is required along with the global one",
+			ErrMissingFrequencyTable)
+	}
+
+	if similarity != "" {
+		table, err := loadSimilarityTable(similarity)
+		if err != nil {
+			return nil, fmt.Errorf("loading similarity table: %w", err)
+		}
+		options = append(options, WithSimilarity(table))
+	}
+
+	return options, nil
+}
+
+func loadFrequencyTable(path string) (*samurai.FrequencyTable, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return samurai.NewFrequencyTableFromReader(file)
+}
+
+func loadSimilarityTable(path string) (*gentest.SimilarityTable, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return gentest.NewSimilarityTableFromReader(file)
+}
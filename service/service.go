@@ -0,0 +1,258 @@
+// Package service exposes the splitting and expansion algorithms behind a single entry point, with
+// every required resource loaded up front, so they can be served to other processes.
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eroatta/token/amap"
+	"github.com/eroatta/token/basic"
+	"github.com/eroatta/token/conserv"
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/gentest"
+	"github.com/eroatta/token/greedy"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/samurai"
+	"github.com/eroatta/token/split"
+)
+
+// Algorithm names accepted by the service.
+const (
+	Conserv = "conserv"
+	Greedy  = "greedy"
+	Samurai = "samurai"
+	GenTest = "gentest"
+	Basic   = "basic"
+	AMAP    = "amap"
+)
+
+var (
+	// ErrUnknownAlgorithm indicates that the requested algorithm is not supported by the operation.
+	ErrUnknownAlgorithm = errors.New("unknown algorithm")
+	// ErrNoTokens indicates that the request holds no tokens.
+	ErrNoTokens = errors.New("no tokens")
+	// ErrTooManyTokens indicates that the request holds more tokens than allowed.
+	ErrTooManyTokens = errors.New("too many tokens")
+	// ErrMissingFrequencyTable indicates that only one of the local and global frequency tables was given.
+	ErrMissingFrequencyTable = errors.New("missing frequency table")
+)
+
+// Service runs the splitting and expansion algorithms using a set of preloaded resources.
+type Service struct {
+	greedyList lists.List
+	expansions expansion.Set
+	tokenCtx   samurai.TokenContext
+	prefixes   lists.List
+	suffixes   lists.List
+	similarity gentest.SimilarityCalculator
+	maxTokens  int
+}
+
+// New creates a service, loading the default lists unless they're replaced by options.
+// The lazy lists, such as lists.Dictionary, are loaded at this point, so the first request doesn't
+// pay for their loading.
+func New(options ...Option) *Service {
+	s := &Service{
+		greedyList: greedy.DefaultList,
+		expansions: basic.DefaultExpansions,
+		prefixes:   lists.Prefixes,
+		suffixes:   lists.Suffixes,
+		maxTokens:  DefaultMaxTokens,
+	}
+	for _, option := range options {
+		option(s)
+	}
+
+	// force the load of lazy lists and sets
+	s.greedyList.Size()
+	s.expansions.Contains("")
+
+	return s
+}
+
+// SplitRequest holds the tokens to split and the information required by the algorithm.
+type SplitRequest struct {
+	Algorithm string   `json:"algorithm"`
+	Tokens    []string `json:"tokens"`
+	// Context holds the context words for GenTest.
+	Context []string `json:"context,omitempty"`
+}
+
+// SplitResult holds the split for a token, or the reason it couldn't be split.
+type SplitResult struct {
+	Token string   `json:"token"`
+	Words []string `json:"words"`
+	// Parts holds the soft words along with their offsets, unknown flags and provenance.
+	Parts split.Result `json:"parts"`
+	Error string       `json:"error,omitempty"`
+}
+
+// Split splits every token on the request using the requested algorithm. An error is returned when
+// the request itself is invalid, while the errors for a single token are reported on its result.
+func (s *Service) Split(req SplitRequest) ([]SplitResult, error) {
+	if err := s.validate(req.Tokens); err != nil {
+		return nil, err
+	}
+
+	var splitter func(token string) (split.Result, error)
+	switch algorithm := strings.ToLower(req.Algorithm); algorithm {
+	case Conserv:
+		splitter = func(token string) (split.Result, error) {
+			splitted, err := conserv.TrySplit(token)
+			return split.FromWords(token, strings.Fields(splitted), algorithm), err
+		}
+	case Greedy:
+		splitter = func(token string) (split.Result, error) {
+			return greedy.TrySplitParts(token, s.greedyList)
+		}
+	case Samurai:
+		splitter = func(token string) (split.Result, error) {
+			splitted, err := samurai.TrySplit(token, s.tokenCtx, s.prefixes, s.suffixes)
+			return split.FromWords(token, strings.Fields(splitted), algorithm), err
+		}
+	case GenTest:
+		context := lists.NewBuilder().Add(req.Context...).Build()
+		splitter = func(token string) (split.Result, error) {
+			words, err := gentest.TrySplit(token, s.similarity, context, s.expansions)
+			return split.FromWords(token, words, algorithm), err
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q can't split tokens", ErrUnknownAlgorithm, req.Algorithm)
+	}
+
+	results := make([]SplitResult, 0, len(req.Tokens))
+	for _, token := range req.Tokens {
+		parts, err := splitter(token)
+		results = append(results, newSplitResult(token, parts, err))
+	}
+
+	return results, nil
+}
+
+func newSplitResult(token string, parts split.Result, err error) SplitResult {
+	if err != nil {
+		return SplitResult{Token: token, Words: []string{}, Parts: split.Result{}, Error: err.Error()}
+	}
+
+	if parts == nil {
+		parts = split.Result{}
+	}
+
+	return SplitResult{Token: token, Words: parts.Words(), Parts: parts}
+}
+
+// Scope holds the scope levels of a token for AMAP.
+type Scope struct {
+	VariableDeclarations []string `json:"variable_declarations,omitempty"`
+	MethodName           string   `json:"method_name,omitempty"`
+	MethodBodyText       string   `json:"method_body_text,omitempty"`
+	MethodComments       []string `json:"method_comments,omitempty"`
+	Statements           []string `json:"statements,omitempty"`
+	Identifiers          []string `json:"identifiers,omitempty"`
+	ReceiverType         string   `json:"receiver_type,omitempty"`
+	StructFields         []string `json:"struct_fields,omitempty"`
+	FileComments         []string `json:"file_comments,omitempty"`
+	PackageComments      []string `json:"package_comments,omitempty"`
+	Imports              []string `json:"imports,omitempty"`
+}
+
+// ExpandRequest holds the tokens to expand and the information required by the algorithm.
+type ExpandRequest struct {
+	Algorithm string   `json:"algorithm"`
+	Tokens    []string `json:"tokens"`
+	// SourceWords and Phrases hold the words and phrases from the source code for Basic.
+	SourceWords []string          `json:"source_words,omitempty"`
+	Phrases     map[string]string `json:"phrases,omitempty"`
+	// Scope and ReferenceText hold the token scope and the reference text for AMAP.
+	Scope         Scope    `json:"scope"`
+	ReferenceText []string `json:"reference_text,omitempty"`
+	// Context holds the context words for GenTest.
+	Context []string `json:"context,omitempty"`
+}
+
+// ExpandResult holds the expansions for a token, or the reason it couldn't be expanded.
+type ExpandResult struct {
+	Token      string   `json:"token"`
+	Expansions []string `json:"expansions"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// Expand expands every token on the request using the requested algorithm. An error is returned when
+// the request itself is invalid, while the errors for a single token are reported on its result.
+func (s *Service) Expand(req ExpandRequest) ([]ExpandResult, error) {
+	if err := s.validate(req.Tokens); err != nil {
+		return nil, err
+	}
+
+	var expand func(token string) ([]string, error)
+	switch strings.ToLower(req.Algorithm) {
+	case Basic:
+		srcWords := expansion.NewSetBuilder().AddStrings(req.SourceWords...).Build()
+		expand = func(token string) ([]string, error) {
+			return basic.TryExpand(token, srcWords, req.Phrases, s.expansions)
+		}
+	case AMAP:
+		scope := newTokenScope(req.Scope)
+		index := amap.NewReferenceIndex(req.ReferenceText)
+		expand = func(token string) ([]string, error) {
+			return amap.TryExpandWithIndex(token, scope, index)
+		}
+	case GenTest:
+		context := lists.NewBuilder().Add(req.Context...).Build()
+		expand = func(token string) ([]string, error) {
+			return gentest.TryExpand(token, s.similarity, context, s.expansions)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q can't expand tokens", ErrUnknownAlgorithm, req.Algorithm)
+	}
+
+	results := make([]ExpandResult, 0, len(req.Tokens))
+	for _, token := range req.Tokens {
+		expansions, err := expand(token)
+		results = append(results, newExpandResult(token, expansions, err))
+	}
+
+	return results, nil
+}
+
+func newExpandResult(token string, expansions []string, err error) ExpandResult {
+	if err != nil {
+		return ExpandResult{Token: token, Expansions: []string{}, Error: err.Error()}
+	}
+
+	if expansions == nil {
+		expansions = []string{}
+	}
+
+	return ExpandResult{Token: token, Expansions: expansions}
+}
+
+func newTokenScope(scope Scope) amap.TokenScope {
+	return amap.NewTokenScopeBuilder().
+		VariableDeclarations(scope.VariableDeclarations...).
+		MethodName(scope.MethodName).
+		MethodBodyText(scope.MethodBodyText).
+		MethodComments(scope.MethodComments...).
+		Statements(scope.Statements...).
+		Identifiers(scope.Identifiers...).
+		ReceiverType(scope.ReceiverType).
+		StructFields(scope.StructFields...).
+		FileComments(scope.FileComments...).
+		PackageComments(scope.PackageComments...).
+		Imports(scope.Imports...).
+		Build()
+}
+
+func (s *Service) validate(tokens []string) error {
+	if len(tokens) == 0 {
+		return ErrNoTokens
+	}
+
+	if s.maxTokens > 0 && len(tokens) > s.maxTokens {
+		return fmt.Errorf("%w: %d tokens, up to %d allowed", ErrTooManyTokens, len(tokens), s.maxTokens)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/eroatta/token/expansion"
+	"github.com/eroatta/token/gentest"
+	"github.com/eroatta/token/lists"
+	"github.com/eroatta/token/samurai"
+	"github.com/eroatta/token/split"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestService(options ...Option) *Service {
+	local := samurai.NewFrequencyTable()
+	local.SetOccurrences("get", 3)
+	local.SetOccurrences("string", 10)
+	global := samurai.NewFrequencyTable()
+	global.SetOccurrences("get", 30)
+	global.SetOccurrences("string", 100)
+
+	similarity := gentest.NewSimilarityTable()
+	similarity.Set("http", "response", 0.9)
+
+	defaults := []Option{
+		WithGreedyList(lists.NewBuilder().Add("get", "string", "http", "response").Build()),
+		WithExpansions(expansion.NewSetBuilder().AddStrings("http", "response", "parser").Build()),
+		WithFrequencyTables(local, global),
+		WithSimilarity(similarity),
+	}
+
+	return New(append(defaults, options...)...)
+}
+
+func TestSplit_OnService_ShouldReturnSplitsForEachAlgorithm(t *testing.T) {
+	tests := []struct {
+		name      string
+		algorithm string
+		tokens    []string
+		expected  []SplitResult
+	}{
+		{"conserv", "conserv", []string{"httpResponse", ""}, []SplitResult{
+			{Token: "httpResponse", Words: []string{"http", "response"}, Parts: split.Result{
+				{Word: "http", Offset: 0, Provenance: "conserv"},
+				{Word: "response", Offset: 4, Provenance: "conserv"},
+			}},
+			{Token: "", Words: []string{}, Parts: split.Result{}, Error: "empty token"},
+		}},
+		{"greedy", "greedy", []string{"getstring"}, []SplitResult{
+			{Token: "getstring", Words: []string{"get", "string"}, Parts: split.Result{
+				{Word: "get", Offset: 0, Provenance: "greedy"},
+				{Word: "string", Offset: 3, Provenance: "greedy"},
+			}},
+		}},
+		{"samurai", "Samurai", []string{"getString"}, []SplitResult{
+			{Token: "getString", Words: []string{"get", "string"}, Parts: split.Result{
+				{Word: "get", Offset: 0, Provenance: "samurai"},
+				{Word: "string", Offset: 3, Provenance: "samurai"},
+			}},
+		}},
+		{"gentest", "gentest", []string{"httpresponse"}, []SplitResult{
+			{Token: "httpresponse", Words: []string{"http", "response"}, Parts: split.Result{
+				{Word: "http", Offset: 0, Provenance: "gentest"},
+				{Word: "response", Offset: 4, Provenance: "gentest"},
+			}},
+		}},
+	}
+
+	s := newTestService()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Split(SplitRequest{Algorithm: tt.algorithm, Tokens: tt.tokens, Context: []string{"http", "response"}})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestSplit_OnServiceWithoutResources_ShouldReturnTokenErrors(t *testing.T) {
+	s := New(WithGreedyList(lists.NewBuilder().Build()), WithExpansions(expansion.NewSetBuilder().Build()))
+
+	samuraiResults, err := s.Split(SplitRequest{Algorithm: "samurai", Tokens: []string{"getString"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "empty context", samuraiResults[0].Error)
+
+	gentestResults, err := s.Split(SplitRequest{Algorithm: "gentest", Tokens: []string{"getString"}, Context: []string{"get"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "nil similarity calculator", gentestResults[0].Error)
+}
+
+func TestSplit_OnInvalidRequest_ShouldReturnError(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      SplitRequest
+		expected error
+	}{
+		{"unknown_algorithm", SplitRequest{Algorithm: "amap", Tokens: []string{"str"}}, ErrUnknownAlgorithm},
+		{"no_tokens", SplitRequest{Algorithm: "conserv"}, ErrNoTokens},
+		{"too_many_tokens", SplitRequest{Algorithm: "conserv", Tokens: []string{"a", "b", "c"}}, ErrTooManyTokens},
+	}
+
+	s := newTestService(WithMaxTokens(2))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Split(tt.req)
+
+			assert.Nil(t, got)
+			assert.True(t, errors.Is(err, tt.expected))
+		})
+	}
+}
+
+func TestExpand_OnService_ShouldReturnExpansionsForEachAlgorithm(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      ExpandRequest
+		expected []ExpandResult
+	}{
+		{"basic", ExpandRequest{Algorithm: "basic", Tokens: []string{"prsr", "json"},
+			Phrases: map[string]string{"json": "java-script-object-notation"}}, []ExpandResult{
+			{Token: "prsr", Expansions: []string{"parser"}},
+			{Token: "json", Expansions: []string{"java script object notation"}},
+		}},
+		{"amap", ExpandRequest{Algorithm: "amap", Tokens: []string{"buf", "zzz"},
+			Scope: Scope{Statements: []string{"buf := new(bytes.Buffer)"}}}, []ExpandResult{
+			{Token: "buf", Expansions: []string{"buffer"}},
+			{Token: "zzz", Expansions: []string{}},
+		}},
+		{"gentest", ExpandRequest{Algorithm: "gentest", Tokens: []string{"httpresp"},
+			Context: []string{"http", "response"}}, []ExpandResult{
+			{Token: "httpresp", Expansions: []string{"http", "response"}},
+		}},
+		{"empty_token", ExpandRequest{Algorithm: "amap", Tokens: []string{""}}, []ExpandResult{
+			{Token: "", Expansions: []string{}, Error: "empty token"},
+		}},
+	}
+
+	s := newTestService()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.Expand(tt.req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestExpand_OnUnknownAlgorithm_ShouldReturnError(t *testing.T) {
+	s := newTestService()
+
+	got, err := s.Expand(ExpandRequest{Algorithm: "greedy", Tokens: []string{"str"}})
+
+	assert.Nil(t, got)
+	assert.True(t, errors.Is(err, ErrUnknownAlgorithm))
+}
+
+func TestLoadTables_OnFiles_ShouldReturnOptionsOrError(t *testing.T) {
+	dir := t.TempDir()
+	frequencies := filepath.Join(dir, "frequencies.txt")
+	similarity := filepath.Join(dir, "similarity.txt")
+	assert.NoError(t, os.WriteFile(frequencies, []byte("get 3\nstring 10\n"), 0o644))
+	assert.NoError(t, os.WriteFile(similarity, []byte("http response 0.9\n"), 0o644))
+
+	tests := []struct {
+		name       string
+		local      string
+		global     string
+		similarity string
+		options    int
+		err        error
+	}{
+		{"no_files", "", "", "", 0, nil},
+		{"every_file", frequencies, frequencies, similarity, 2, nil},
+		{"only_local_frequencies", frequencies, "", similarity, 0, ErrMissingFrequencyTable},
+		{"only_global_frequencies", "", frequencies, "", 0, ErrMissingFrequencyTable},
+		{"missing_file", frequencies, filepath.Join(dir, "missing.txt"), "", 0, os.ErrNotExist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, err := LoadTables(tt.local, tt.global, tt.similarity)
+
+			assert.True(t, errors.Is(err, tt.err), fmt.Sprintf("got: %v", err))
+			assert.Len(t, options, tt.options)
+		})
+	}
+}
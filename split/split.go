@@ -0,0 +1,97 @@
+// Package split defines the structured result shared by the splitting algorithms, which holds
+// each soft word along with its position on the token and the algorithm that produced it.
+package split
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Part is a soft word produced when splitting a token.
+type Part struct {
+	// Word is the soft word, in lower case.
+	Word string `json:"word"`
+	// Offset is the position (in bytes) of the soft word on the original token.
+	Offset int `json:"offset"`
+	// Unknown indicates that the soft word wasn't recognised as a word by the algorithm.
+	Unknown bool `json:"unknown"`
+	// Provenance names the algorithm that produced the soft word.
+	Provenance string `json:"provenance"`
+}
+
+// Result is the ordered list of soft words produced when splitting a token.
+type Result []Part
+
+// Words returns the soft words on the result.
+func (r Result) Words() []string {
+	words := make([]string, len(r))
+	for i, part := range r {
+		words[i] = part.Word
+	}
+
+	return words
+}
+
+// Known returns a new result including only the recognised soft words.
+func (r Result) Known() Result {
+	known := make(Result, 0, len(r))
+	for _, part := range r {
+		if !part.Unknown {
+			known = append(known, part)
+		}
+	}
+
+	return known
+}
+
+// Join joins the soft words on the result using the given separator.
+func (r Result) Join(separator string) string {
+	return strings.Join(r.Words(), separator)
+}
+
+// FromWords creates a result from a list of soft words produced for the token, locating each word
+// on the token to set its offset. Words are looked up in order and without considering the case.
+// If a word can't be found, its offset is the position where the previous word ended.
+func FromWords(token string, words []string, provenance string) Result {
+	result := make(Result, 0, len(words))
+	position := 0
+	for _, word := range words {
+		lowerWord := strings.ToLower(word)
+
+		offset := position
+		if start, end := indexFold(token, position, lowerWord); start >= 0 {
+			offset = start
+			position = end
+		}
+
+		result = append(result, Part{
+			Word:       lowerWord,
+			Offset:     offset,
+			Provenance: provenance,
+		})
+	}
+
+	return result
+}
+
+// indexFold looks for the first occurrence of the word on the token, starting at the given position and
+// without considering the case. It returns the start and the end of the occurrence on the token, as the
+// case of a character can take a different number of bytes, or -1 if the word isn't found.
+func indexFold(token string, position int, word string) (int, int) {
+	length := utf8.RuneCountInString(word)
+	for start := position; start < len(token); {
+		end := start
+		for n := 0; n < length && end < len(token); n++ {
+			_, size := utf8.DecodeRuneInString(token[end:])
+			end += size
+		}
+		if strings.EqualFold(token[start:end], word) {
+			return start, end
+		}
+
+		_, size := utf8.DecodeRuneInString(token[start:])
+		start += size
+	}
+
+	return -1, -1
+}
@@ -0,0 +1,44 @@
+package split
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromWords_ShouldLocateEachWord(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+		words []string
+		want  Result
+	}{
+		{"empty", "", []string{}, Result{}},
+		{"camel_case", "httpResponse", []string{"http", "response"},
+			Result{{Word: "http", Offset: 0, Provenance: "test"}, {Word: "response", Offset: 4, Provenance: "test"}}},
+		{"with_markers", "get_HTTP2Code", []string{"get", "http", "2", "code"},
+			Result{{"get", 0, false, "test"}, {"http", 4, false, "test"}, {"2", 8, false, "test"}, {"code", 9, false, "test"}}},
+		{"repeated_words", "aaA", []string{"a", "a", "a"},
+			Result{{"a", 0, false, "test"}, {"a", 1, false, "test"}, {"a", 2, false, "test"}}},
+		{"missing_word", "getValue", []string{"get", "something", "value"},
+			Result{{"get", 0, false, "test"}, {"something", 3, false, "test"}, {"value", 3, false, "test"}}},
+		{"lower_case_with_different_length", "ȺbcDef", []string{"ⱥbc", "def"},
+			Result{{"ⱥbc", 0, false, "test"}, {"def", 4, false, "test"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromWords(tt.token, tt.words, "test")
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResult_ShouldProvideWordsKnownAndJoin(t *testing.T) {
+	result := Result{{Word: "get", Offset: 0}, {Word: "xz", Offset: 3, Unknown: true}, {Word: "value", Offset: 5}}
+
+	assert.Equal(t, []string{"get", "xz", "value"}, result.Words())
+	assert.Equal(t, Result{{Word: "get", Offset: 0}, {Word: "value", Offset: 5}}, result.Known())
+	assert.Equal(t, "get-xz-value", result.Join("-"))
+}